@@ -0,0 +1,73 @@
+// Package balance retrieves the operator's account balances.
+package balance
+
+import (
+	"context"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// Service queries the operator's balances.
+type Service struct {
+	client client.Doer
+}
+
+// NewService returns a Service backed by the given client.
+func NewService(c client.Doer) *Service {
+	return &Service{client: c}
+}
+
+// Servicer captures the exported methods of Service so applications can
+// substitute a fake in their own tests.
+type Servicer interface {
+	Get(ctx context.Context) (string, error)
+	GetAll(ctx context.Context) (*Response, error)
+	Ping(ctx context.Context) error
+}
+
+var _ Servicer = (*Service)(nil)
+
+// Response carries all balances returned by the balance endpoint.
+type Response struct {
+	// Balance is the IDR balance in whole Rupiah.
+	Balance float64 `json:"balance"`
+	// UsdtBalance is the USDT balance.
+	UsdtBalance float64 `json:"usdt_balance"`
+}
+
+// FormatIDR returns the IDR balance formatted for display.
+func (r *Response) FormatIDR() string { return client.FormatAmountIDR(r.Balance) }
+
+// FormatUSDT returns the USDT balance formatted for display.
+func (r *Response) FormatUSDT() string { return client.FormatAmountUSDT(r.UsdtBalance) }
+
+// GetAll returns all operator balances as numeric values.
+func (s *Service) GetAll(ctx context.Context) (*Response, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.balance.get_all")
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingBalance))
+	var resp Response
+	if err := s.client.Get(ctx, s.client.Endpoint(constants.EndpointBalance), &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogBalanceRetrieved),
+		"balance", resp.Balance,
+		"usdtBalance", resp.UsdtBalance)
+	return &resp, nil
+}
+
+// Ping verifies that the GSPAY2 API is reachable and the operator
+// credentials are valid. See client.Client.Ping for the error contract.
+func (s *Service) Ping(ctx context.Context) error { return s.client.Ping(ctx) }
+
+// Get returns the operator's IDR balance formatted for display. It is kept
+// for backward compatibility; new code should prefer GetAll.
+func (s *Service) Get(ctx context.Context) (string, error) {
+	resp, err := s.GetAll(ctx)
+	if err != nil {
+		return "", err
+	}
+	return resp.FormatIDR(), nil
+}
@@ -0,0 +1,48 @@
+package balance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+)
+
+func newMockService(data map[string]any) *Service {
+	c := client.NewMock(client.MockResponse{Body: map[string]any{
+		"code":    200,
+		"message": "OK",
+		"data":    data,
+	}})
+	return NewService(c)
+}
+
+func TestServiceGetAll(t *testing.T) {
+	svc := newMockService(map[string]any{"balance": 1234567.89, "usdt_balance": 12.5})
+	resp, err := svc.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if resp.Balance != 1234567.89 {
+		t.Errorf("Balance = %v, want 1234567.89", resp.Balance)
+	}
+	if resp.UsdtBalance != 12.5 {
+		t.Errorf("UsdtBalance = %v, want 12.5", resp.UsdtBalance)
+	}
+	if got := resp.FormatIDR(); got != "Rp 1.234.567,89" {
+		t.Errorf("FormatIDR = %q, want Rp 1.234.567,89", got)
+	}
+	if got := resp.FormatUSDT(); got != "12.50 USDT" {
+		t.Errorf("FormatUSDT = %q, want 12.50 USDT", got)
+	}
+}
+
+func TestServiceGetDelegatesToGetAll(t *testing.T) {
+	svc := newMockService(map[string]any{"balance": 50000, "usdt_balance": 0})
+	got, err := svc.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != "Rp 50.000,00" {
+		t.Errorf("Get = %q, want Rp 50.000,00", got)
+	}
+}
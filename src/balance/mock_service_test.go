@@ -0,0 +1,41 @@
+package balance
+
+import (
+	"context"
+	"testing"
+)
+
+// mockServicer is a handwritten mock of Servicer.
+type mockServicer struct {
+	getFunc    func(ctx context.Context) (string, error)
+	getAllFunc func(ctx context.Context) (*Response, error)
+	pingFunc   func(ctx context.Context) error
+}
+
+func (m *mockServicer) Get(ctx context.Context) (string, error) {
+	return m.getFunc(ctx)
+}
+
+func (m *mockServicer) GetAll(ctx context.Context) (*Response, error) {
+	return m.getAllFunc(ctx)
+}
+
+func (m *mockServicer) Ping(ctx context.Context) error {
+	return m.pingFunc(ctx)
+}
+
+var _ Servicer = (*mockServicer)(nil)
+
+func TestMockServicerSubstitutes(t *testing.T) {
+	mock := &mockServicer{
+		getFunc: func(ctx context.Context) (string, error) { return "Rp 1.000,00", nil },
+	}
+	var svc Servicer = mock
+	got, err := svc.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != "Rp 1.000,00" {
+		t.Errorf("Get = %q", got)
+	}
+}
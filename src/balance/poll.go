@@ -0,0 +1,92 @@
+package balance
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// MinPollInterval is the shortest interval Poll accepts, so a
+// misconfigured poller cannot hammer the balance endpoint.
+const MinPollInterval = 10 * time.Second
+
+// DefaultPollBuffer is the channel buffer Poll uses unless overridden
+// with WithPollBuffer.
+const DefaultPollBuffer = 1
+
+// BalanceUpdate is one observation emitted by Poll. When the underlying
+// GetAll call fails, Err is set and the balances are zero.
+type BalanceUpdate struct {
+	Balance     float64
+	UsdtBalance float64
+	Err         error
+	Timestamp   time.Time
+}
+
+// BalancePollOption customizes a single Poll call.
+type BalancePollOption func(*pollOptions)
+
+type pollOptions struct {
+	buffer int
+}
+
+// WithPollBuffer sets the capacity of the channel returned by Poll.
+// Updates that arrive while the channel is full are dropped. Values below
+// one are ignored.
+func WithPollBuffer(n int) BalancePollOption {
+	return func(o *pollOptions) {
+		if n >= 1 {
+			o.buffer = n
+		}
+	}
+}
+
+// Poll fetches all balances every interval and emits them on the returned
+// channel until ctx is cancelled, at which point the channel is closed.
+// A slow consumer never blocks polling: updates are dropped when the
+// channel is full. The interval must be at least MinPollInterval.
+func (s *Service) Poll(ctx context.Context, interval time.Duration, opts ...BalancePollOption) (<-chan BalanceUpdate, error) {
+	if interval < MinPollInterval {
+		return nil, errors.New(s.client.Lang(), errors.ErrInvalidConfiguration,
+			"poll interval must be at least "+MinPollInterval.String())
+	}
+	options := pollOptions{buffer: DefaultPollBuffer}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return s.poll(ctx, interval, options.buffer), nil
+}
+
+// poll runs the polling loop without the interval floor, so tests can use
+// short intervals.
+func (s *Service) poll(ctx context.Context, interval time.Duration, buffer int) <-chan BalanceUpdate {
+	updates := make(chan BalanceUpdate, buffer)
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer close(updates)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			update := BalanceUpdate{Timestamp: time.Now()}
+			if resp, err := s.GetAll(ctx); err != nil {
+				update.Err = err
+			} else {
+				update.Balance = resp.Balance
+				update.UsdtBalance = resp.UsdtBalance
+			}
+			select {
+			case updates <- update:
+			default:
+			}
+		}
+	}()
+	return updates
+}
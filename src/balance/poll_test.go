@@ -0,0 +1,101 @@
+package balance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// newPollService serves the same balance payload on every request, unlike
+// newMockService whose mock transport answers only once.
+func newPollService(t *testing.T) *Service {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{"balance":1234567.89,"usdt_balance":12.5}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return NewService(client.New("auth", "secret",
+		client.WithBaseURL(srv.URL),
+		client.WithRetries(0)))
+}
+
+func TestPollRejectsShortInterval(t *testing.T) {
+	svc := newMockService(map[string]any{"balance": 50000, "usdt_balance": 0})
+	if _, err := svc.Poll(context.Background(), time.Second); !errors.Is(err, errors.ErrInvalidConfiguration) {
+		t.Errorf("Poll(1s): error = %v, want ErrInvalidConfiguration", err)
+	}
+}
+
+func TestPollEmitsUpdates(t *testing.T) {
+	svc := newPollService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := svc.poll(ctx, 5*time.Millisecond, 1)
+	select {
+	case u := <-updates:
+		if u.Err != nil {
+			t.Fatalf("update.Err = %v", u.Err)
+		}
+		if u.Balance != 1234567.89 || u.UsdtBalance != 12.5 {
+			t.Errorf("update = %+v, want balances 1234567.89/12.5", u)
+		}
+		if u.Timestamp.IsZero() {
+			t.Error("update.Timestamp is zero")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no update within 1s")
+	}
+}
+
+func TestPollStopsOnCancel(t *testing.T) {
+	svc := newPollService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := svc.poll(ctx, 5*time.Millisecond, 1)
+	cancel()
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-updates:
+			if !ok {
+				return // channel closed cleanly
+			}
+		case <-deadline:
+			t.Fatal("channel not closed within 1s of cancellation")
+		}
+	}
+}
+
+func TestPollDropsWhenConsumerSlow(t *testing.T) {
+	svc := newPollService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := svc.poll(ctx, time.Millisecond, 1)
+	// Never read: the loop must keep ticking and then shut down cleanly
+	// rather than blocking on the full channel.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	// Drain whatever is buffered; the channel must close promptly, proving
+	// the loop never blocked on the full channel.
+	deadline := time.After(time.Second)
+	received := 0
+	for {
+		select {
+		case _, ok := <-updates:
+			if !ok {
+				// Far more than `received` ticks elapsed; the rest were
+				// dropped rather than blocking the loop.
+				if received > 5 {
+					t.Errorf("received %d updates after cancel, want only the few buffered ones", received)
+				}
+				return
+			}
+			received++
+		case <-deadline:
+			t.Fatal("channel not closed within 1s of cancellation")
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/internal/sanitize"
+)
+
+// RequestLog is the machine-readable record of one HTTP attempt, emitted
+// to the audit logger before the response is read. The endpoint is always
+// sanitized: the operator auth key is redacted regardless of debug mode.
+type RequestLog struct {
+	// Method is the HTTP method of the attempt.
+	Method string
+	// Endpoint is the sanitized request path including query parameters.
+	Endpoint string
+	// Attempt is the zero-based attempt number; retries increment it.
+	Attempt int
+	// Timestamp is when the attempt started.
+	Timestamp time.Time
+	// RequestID is the correlation ID sent as X-Request-ID.
+	RequestID string
+}
+
+// ResponseLog is the machine-readable record of the outcome of one HTTP
+// attempt.
+type ResponseLog struct {
+	// StatusCode is the HTTP status of the attempt, or zero when the
+	// attempt failed before a response was received.
+	StatusCode int
+	// Duration is how long the attempt took.
+	Duration time.Duration
+	// Attempt is the zero-based attempt number matching the RequestLog.
+	Attempt int
+	// RequestID is the correlation ID matching the RequestLog.
+	RequestID string
+	// Success reports whether the attempt received a non-error response.
+	Success bool
+}
+
+// WithAuditLogger registers a function called after every HTTP attempt —
+// including retries and attempts whose final result is an error — with a
+// structured request/response record suitable for forwarding to a SIEM.
+// The function is called synchronously on the request goroutine.
+func WithAuditLogger(fn func(RequestLog, ResponseLog)) Option {
+	return func(c *Client) { c.auditLogger = fn }
+}
+
+// auditAttempt emits one audit record for a completed HTTP attempt. resp
+// is nil when the attempt failed at the transport level.
+func (c *Client) auditAttempt(method, endpoint, requestID string, attempt int, start time.Time, resp *http.Response) {
+	if c.auditLogger == nil {
+		return
+	}
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	c.auditLogger(RequestLog{
+		Method:    method,
+		Endpoint:  sanitize.Endpoint(endpoint),
+		Attempt:   attempt,
+		Timestamp: start,
+		RequestID: requestID,
+	}, ResponseLog{
+		StatusCode: statusCode,
+		Duration:   time.Since(start),
+		Attempt:    attempt,
+		RequestID:  requestID,
+		Success:    resp != nil && statusCode < http.StatusBadRequest,
+	})
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type auditRecorder struct {
+	mu      sync.Mutex
+	entries []struct {
+		req  RequestLog
+		resp ResponseLog
+	}
+}
+
+func (a *auditRecorder) record(req RequestLog, resp ResponseLog) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries = append(a.entries, struct {
+		req  RequestLog
+		resp ResponseLog
+	}{req, resp})
+}
+
+func TestAuditLoggerOnSuccess(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	rec := &auditRecorder{}
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithRequestIDGenerator(UUIDRequestIDGenerator),
+		WithAuditLogger(rec.record))
+	var out struct{}
+	if err := c.Get(context.Background(), c.Endpoint("balance"), &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(rec.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(rec.entries))
+	}
+	e := rec.entries[0]
+	if e.req.Method != http.MethodGet || e.req.Attempt != 0 {
+		t.Errorf("request log = %+v, want GET attempt 0", e.req)
+	}
+	if strings.Contains(e.req.Endpoint, "auth") {
+		t.Errorf("endpoint %q leaks the auth key", e.req.Endpoint)
+	}
+	if e.req.RequestID == "" || e.req.RequestID != e.resp.RequestID {
+		t.Errorf("request IDs = %q / %q, want matching non-empty", e.req.RequestID, e.resp.RequestID)
+	}
+	if e.req.Timestamp.IsZero() {
+		t.Error("request timestamp is zero")
+	}
+	if !e.resp.Success || e.resp.StatusCode != http.StatusOK {
+		t.Errorf("response log = %+v, want success with status 200", e.resp)
+	}
+}
+
+func TestAuditLoggerOnFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	rec := &auditRecorder{}
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithRetries(1), WithRetryWait(time.Millisecond, time.Millisecond),
+		WithAuditLogger(rec.record))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err == nil {
+		t.Fatal("Get: want error, got nil")
+	}
+	if len(rec.entries) != 2 {
+		t.Fatalf("got %d audit entries, want 2 (initial + retry)", len(rec.entries))
+	}
+	for i, e := range rec.entries {
+		if e.req.Attempt != i || e.resp.Attempt != i {
+			t.Errorf("entry %d attempts = %d/%d, want %d", i, e.req.Attempt, e.resp.Attempt, i)
+		}
+		if e.resp.Success || e.resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("entry %d response = %+v, want failed with status 500", i, e.resp)
+		}
+	}
+}
+
+func TestAuditLoggerOnRetrySuccess(t *testing.T) {
+	var calls int
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		first := calls == 1
+		mu.Unlock()
+		if first {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	rec := &auditRecorder{}
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithRetries(2), WithRetryWait(time.Millisecond, time.Millisecond),
+		WithAuditLogger(rec.record))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(rec.entries) != 2 {
+		t.Fatalf("got %d audit entries, want 2", len(rec.entries))
+	}
+	if rec.entries[0].resp.Success {
+		t.Errorf("first attempt = %+v, want failure", rec.entries[0].resp)
+	}
+	if e := rec.entries[1]; !e.resp.Success || e.resp.Attempt != 1 {
+		t.Errorf("second attempt = %+v, want success on attempt 1", e.resp)
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// WithBaseURLValidator controls how strictly the base URL is checked. In
+// strict mode the URL must use http or https, have a host, carry no path
+// other than "/" and no query string; violations surface as
+// errors.ErrInvalidConfiguration from the first request. The default
+// non-strict mode only strips a trailing slash.
+func WithBaseURLValidator(strict bool) Option {
+	return func(c *Client) { c.strictBaseURL = strict }
+}
+
+// applyBaseURLValidation checks the base URL after all options have been
+// applied. A non-root path is always worth a warning because endpoint
+// patterns already start with a path; in strict mode it is an error.
+func (c *Client) applyBaseURLValidation() {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		if c.strictBaseURL && c.configErr == nil {
+			c.configErr = c.Error(errors.ErrInvalidConfiguration, fmt.Sprintf("base URL: %v", err))
+		}
+		return
+	}
+	if u.Path != "" && u.Path != "/" {
+		c.logger.Warn(c.I18n(i18n.LogBaseURLHasPath), "baseURL", c.BaseURL)
+	}
+	if !c.strictBaseURL {
+		return
+	}
+	reason := ""
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		reason = "scheme must be http or https"
+	case u.Host == "":
+		reason = "host is empty"
+	case u.Path != "" && u.Path != "/":
+		reason = "path must be empty"
+	case u.RawQuery != "":
+		reason = "query string must be empty"
+	}
+	if reason != "" && c.configErr == nil {
+		c.configErr = c.Error(errors.ErrInvalidConfiguration, fmt.Sprintf("base URL %q: %s", c.BaseURL, reason))
+	}
+}
+
+// WithHTTPSOnly controls whether a plain-http base URL is rejected. With
+// enforce=true the first request fails with errors.ErrInsecureURL unless
+// the host is localhost or 127.0.0.1. With enforce=false the client also
+// skips the startup warning otherwise logged for non-local http URLs.
+func WithHTTPSOnly(enforce bool) Option {
+	return func(c *Client) { c.httpsOnly = &enforce }
+}
+
+// applyHTTPSOnly checks the base URL scheme after all options have been
+// applied. Local hosts are exempt so development setups keep working.
+func (c *Client) applyHTTPSOnly() {
+	if !strings.HasPrefix(c.BaseURL, "http://") || c.isLocalBaseURL() {
+		return
+	}
+	switch {
+	case c.httpsOnly == nil:
+		c.logger.Warn(c.I18n(i18n.LogInsecureBaseURL), "baseURL", c.BaseURL)
+	case *c.httpsOnly && c.configErr == nil:
+		c.configErr = c.Error(errors.ErrInsecureURL, c.BaseURL)
+	}
+}
+
+// isLocalBaseURL reports whether the base URL points at the local host.
+func (c *Client) isLocalBaseURL() bool {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	return host == "localhost" || host == "127.0.0.1"
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestWithHTTPSOnlyRejectsHTTP(t *testing.T) {
+	c := New("auth", "secret",
+		WithBaseURL("http://api.example.com"), WithHTTPSOnly(true))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); !errors.Is(err, errors.ErrInsecureURL) {
+		t.Errorf("Get error = %v, want ErrInsecureURL", err)
+	}
+}
+
+func TestWithHTTPSOnlyExemptsLocalhost(t *testing.T) {
+	for _, base := range []string{"http://localhost:8080", "http://127.0.0.1:8080"} {
+		c := New("auth", "secret", WithBaseURL(base), WithHTTPSOnly(true))
+		if c.configErr != nil {
+			t.Errorf("base %q: configErr = %v, want nil", base, c.configErr)
+		}
+	}
+}
+
+func TestWithHTTPSOnlyAcceptsHTTPS(t *testing.T) {
+	c := New("auth", "secret",
+		WithBaseURL("https://api.example.com"), WithHTTPSOnly(true))
+	if c.configErr != nil {
+		t.Errorf("configErr = %v, want nil", c.configErr)
+	}
+}
+
+func TestInsecureBaseURLWarnsWithoutEnforcement(t *testing.T) {
+	ml := &mockLogger{}
+	New("auth", "secret", WithBaseURL("http://api.example.com"), WithLogger(ml))
+	var warned bool
+	for _, e := range ml.entries {
+		if e.level == "warn" {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Error("no warning logged for non-local http base URL")
+	}
+
+	ml = &mockLogger{}
+	New("auth", "secret", WithBaseURL("http://api.example.com"),
+		WithLogger(ml), WithHTTPSOnly(false))
+	for _, e := range ml.entries {
+		if e.level == "warn" {
+			t.Errorf("unexpected warning %q with HTTPS enforcement explicitly off", e.msg)
+		}
+	}
+}
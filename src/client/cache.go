@@ -0,0 +1,167 @@
+package client
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultCacheSize is the maximum number of cached responses unless
+// overridden with WithCacheSize.
+const DefaultCacheSize = 1000
+
+// CacheStats are cumulative counters for the response cache.
+type CacheStats struct {
+	// Hits counts GET calls answered from the cache.
+	Hits uint64
+	// Misses counts GET calls that went to the network.
+	Misses uint64
+}
+
+// WithResponseCaching caches successful GET responses in memory for ttl,
+// keyed by the full request URL including query parameters. Repeated
+// status or balance polls within the window are answered without a
+// network call. POST requests are never cached.
+func WithResponseCaching(ttl time.Duration) Option {
+	return func(c *Client) { c.cacheTTL = ttl }
+}
+
+// WithCacheSize caps the number of cached responses. The least recently
+// used entry is evicted first. The default is DefaultCacheSize; values
+// below one are ignored.
+func WithCacheSize(n int) Option {
+	return func(c *Client) {
+		if n >= 1 {
+			c.cacheSize = n
+		}
+	}
+}
+
+// responseCache is an LRU cache of parsed response envelopes.
+type responseCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	hits     uint64
+	misses   uint64
+	now      func() time.Time
+}
+
+type cacheEntry struct {
+	key      string
+	resp     *Response
+	storedAt time.Time
+}
+
+func newResponseCache(ttl time.Duration, capacity int) *responseCache {
+	return &responseCache{
+		ttl:      ttl,
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// get returns the cached response for key when present and fresh.
+func (rc *responseCache) get(key string) (*Response, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	el, ok := rc.entries[key]
+	if !ok {
+		rc.misses++
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if rc.now().Sub(entry.storedAt) > rc.ttl {
+		rc.order.Remove(el)
+		delete(rc.entries, key)
+		rc.misses++
+		return nil, false
+	}
+	rc.order.MoveToFront(el)
+	rc.hits++
+	return entry.resp, true
+}
+
+// put stores a response under key, evicting the least recently used entry
+// when the capacity is exceeded.
+func (rc *responseCache) put(key string, resp *Response) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if el, ok := rc.entries[key]; ok {
+		el.Value.(*cacheEntry).resp = resp
+		el.Value.(*cacheEntry).storedAt = rc.now()
+		rc.order.MoveToFront(el)
+		return
+	}
+	rc.entries[key] = rc.order.PushFront(&cacheEntry{key: key, resp: resp, storedAt: rc.now()})
+	for len(rc.entries) > rc.capacity {
+		oldest := rc.order.Back()
+		rc.order.Remove(oldest)
+		delete(rc.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (rc *responseCache) clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries = make(map[string]*list.Element)
+	rc.order.Init()
+}
+
+func (rc *responseCache) stats() CacheStats {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return CacheStats{Hits: rc.hits, Misses: rc.misses}
+}
+
+// applyCache builds the response cache after all options have been
+// applied, so WithResponseCaching and WithCacheSize compose in any order.
+func (c *Client) applyCache() {
+	if c.cacheTTL <= 0 {
+		return
+	}
+	size := c.cacheSize
+	if size == 0 {
+		size = DefaultCacheSize
+	}
+	c.cache = newResponseCache(c.cacheTTL, size)
+}
+
+// cachedResponse returns the cached envelope for a GET to endpoint, if
+// caching is enabled and the entry is fresh.
+func (c *Client) cachedResponse(method, endpoint string) (*Response, bool) {
+	if c.cache == nil || method != http.MethodGet {
+		return nil, false
+	}
+	return c.cache.get(c.BaseURL + endpoint)
+}
+
+// storeResponse caches the envelope of a successful GET to endpoint.
+func (c *Client) storeResponse(method, endpoint string, resp *Response) {
+	if c.cache == nil || method != http.MethodGet || resp == nil {
+		return
+	}
+	c.cache.put(c.BaseURL+endpoint, resp)
+}
+
+// ClearCache drops all cached responses. It is a no-op when caching is
+// not enabled.
+func (c *Client) ClearCache() {
+	if c.cache != nil {
+		c.cache.clear()
+	}
+}
+
+// CacheStats returns the cache hit and miss counts. All counts are zero
+// when caching is not enabled.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return c.cache.stats()
+}
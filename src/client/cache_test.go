@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newCacheServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		n := calls
+		mu.Unlock()
+		fmt.Fprintf(w, `{"code":200,"message":"OK","data":{"call":%d}}`, n)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestResponseCachingCachesGET(t *testing.T) {
+	srv, calls := newCacheServer(t)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithResponseCaching(time.Minute))
+	var out struct {
+		Call int `json:"call"`
+	}
+	for i := 0; i < 3; i++ {
+		if err := c.Get(context.Background(), "/status?id=1", &out); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if out.Call != 1 {
+			t.Errorf("call %d returned server call %d, want cached 1", i, out.Call)
+		}
+	}
+	if *calls != 1 {
+		t.Errorf("server saw %d calls, want 1", *calls)
+	}
+	stats := c.CacheStats()
+	if stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("stats = %+v, want 2 hits, 1 miss", stats)
+	}
+
+	// Distinct query parameters are distinct entries.
+	if err := c.Get(context.Background(), "/status?id=2", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if *calls != 2 {
+		t.Errorf("server saw %d calls, want 2 after new query", *calls)
+	}
+}
+
+func TestResponseCachingSkipsPOST(t *testing.T) {
+	srv, calls := newCacheServer(t)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithResponseCaching(time.Minute))
+	for i := 0; i < 2; i++ {
+		if err := c.Post(context.Background(), "/create", map[string]any{"n": 1}, nil); err != nil {
+			t.Fatalf("Post: %v", err)
+		}
+	}
+	if *calls != 2 {
+		t.Errorf("server saw %d calls, want 2 (POST never cached)", *calls)
+	}
+}
+
+func TestResponseCachingTTLExpiry(t *testing.T) {
+	srv, calls := newCacheServer(t)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithResponseCaching(time.Minute))
+	current := time.Now()
+	c.cache.now = func() time.Time { return current }
+	var out struct{}
+	if err := c.Get(context.Background(), "/status", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	current = current.Add(2 * time.Minute)
+	if err := c.Get(context.Background(), "/status", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if *calls != 2 {
+		t.Errorf("server saw %d calls, want 2 after TTL expiry", *calls)
+	}
+}
+
+func TestResponseCachingEvictsOldest(t *testing.T) {
+	srv, calls := newCacheServer(t)
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithResponseCaching(time.Minute), WithCacheSize(2))
+	var out struct{}
+	for _, q := range []string{"a", "b", "c"} {
+		if err := c.Get(context.Background(), "/status?id="+q, &out); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+	// "a" was evicted by "c"; re-fetching it hits the server again.
+	if err := c.Get(context.Background(), "/status?id=a", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if *calls != 4 {
+		t.Errorf("server saw %d calls, want 4 after eviction", *calls)
+	}
+	// "c" is still cached.
+	if err := c.Get(context.Background(), "/status?id=c", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if *calls != 4 {
+		t.Errorf("server saw %d calls, want still 4 for cached entry", *calls)
+	}
+}
+
+func TestClearCache(t *testing.T) {
+	srv, calls := newCacheServer(t)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithResponseCaching(time.Minute))
+	var out struct{}
+	if err := c.Get(context.Background(), "/status", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	c.ClearCache()
+	if err := c.Get(context.Background(), "/status", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if *calls != 2 {
+		t.Errorf("server saw %d calls, want 2 after ClearCache", *calls)
+	}
+}
+
+func TestResponseCachingConcurrent(t *testing.T) {
+	srv, _ := newCacheServer(t)
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithResponseCaching(time.Minute), WithCacheSize(8))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			var out struct{}
+			for j := 0; j < 50; j++ {
+				endpoint := fmt.Sprintf("/status?id=%d", j%16)
+				if err := c.Get(context.Background(), endpoint, &out); err != nil {
+					t.Errorf("Get: %v", err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	stats := c.CacheStats()
+	if stats.Hits+stats.Misses != 8*50 {
+		t.Errorf("hits+misses = %d, want %d", stats.Hits+stats.Misses, 8*50)
+	}
+}
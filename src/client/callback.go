@@ -0,0 +1,56 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/gc"
+)
+
+// DefaultMaxCallbackBodySize limits callback request bodies to 1 MB unless
+// overridden with WithMaxCallbackBodySize.
+const DefaultMaxCallbackBodySize int64 = 1 << 20
+
+// WithMaxCallbackBodySize overrides the maximum callback body size accepted
+// by the ParseCallbackFromRequest helpers. Non-positive values restore the
+// default.
+func WithMaxCallbackBodySize(n int64) Option {
+	return func(c *Client) {
+		if n <= 0 {
+			n = DefaultMaxCallbackBodySize
+		}
+		c.maxCallbackBody = n
+	}
+}
+
+// MaxCallbackBodySize returns the maximum callback body size in bytes.
+func (c *Client) MaxCallbackBodySize() int64 { return c.maxCallbackBody }
+
+// ParseCallbackBody validates an incoming webhook request and decodes its
+// JSON body into out. It rejects non-POST methods, non-JSON content types
+// and bodies exceeding MaxCallbackBodySize. The body is read through the
+// shared buffer pool.
+func (c *Client) ParseCallbackBody(r *http.Request, out any) error {
+	if r.Method != http.MethodPost {
+		return c.Error(errors.ErrInvalidHTTPMethod, r.Method)
+	}
+	contentType := r.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+		return c.Error(errors.ErrInvalidContentType, contentType)
+	}
+	buf := gc.Default.Get()
+	defer gc.Default.Put(buf)
+	if _, err := buf.ReadFrom(io.LimitReader(r.Body, c.maxCallbackBody+1)); err != nil {
+		return c.Error(errors.ErrRequestFailed, err)
+	}
+	if int64(buf.Len()) > c.maxCallbackBody {
+		return c.Error(errors.ErrCallbackTooLarge, c.maxCallbackBody)
+	}
+	if err := json.Unmarshal(buf.Bytes(), out); err != nil {
+		return c.Error(errors.ErrInvalidJSON, err)
+	}
+	return nil
+}
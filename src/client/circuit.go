@@ -0,0 +1,148 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// CircuitState is the current state of the client's circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed is the normal state: requests flow through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects every request immediately with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen admits a single probe request after the open
+	// timeout; its outcome decides the next state.
+	CircuitHalfOpen
+)
+
+// String returns the state name for logging.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker is a three-state breaker guarding DoRequest. It is safe
+// for concurrent use.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	timeout   time.Duration
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+// allow reports whether a request may proceed. When the circuit is open it
+// returns false together with the suggested wait until the next probe.
+func (b *circuitBreaker) allow(now time.Time) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitClosed:
+		return 0, true
+	case CircuitOpen:
+		if remaining := b.timeout - now.Sub(b.openedAt); remaining > 0 {
+			return remaining, false
+		}
+		b.state = CircuitHalfOpen
+		b.probing = true
+		return 0, true
+	default: // CircuitHalfOpen
+		if b.probing {
+			return b.timeout, false
+		}
+		b.probing = true
+		return 0, true
+	}
+}
+
+// record feeds a request outcome into the breaker. A nil error closes the
+// circuit; a failed half-open probe re-opens it; consecutive failures in
+// the closed state open it once they reach the threshold.
+func (b *circuitBreaker) record(err error, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil {
+		b.state = CircuitClosed
+		b.failures = 0
+		b.probing = false
+		return
+	}
+	if b.state == CircuitHalfOpen {
+		b.state = CircuitOpen
+		b.openedAt = now
+		b.probing = false
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.state = CircuitOpen
+		b.openedAt = now
+		b.failures = 0
+	}
+}
+
+func (b *circuitBreaker) currentState() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// WithCircuitBreaker guards DoRequest with a circuit breaker: after
+// threshold consecutive failures the circuit opens and calls fail
+// immediately with errors.ErrCircuitOpen. After timeout a single probe
+// request is admitted; success closes the circuit, failure re-opens it.
+// Non-positive values disable the breaker.
+func WithCircuitBreaker(threshold int, timeout time.Duration) Option {
+	return func(c *Client) {
+		if threshold <= 0 || timeout <= 0 {
+			c.breaker = nil
+			return
+		}
+		c.breaker = &circuitBreaker{threshold: threshold, timeout: timeout}
+	}
+}
+
+// CircuitState returns the current circuit breaker state. Without
+// WithCircuitBreaker the circuit is permanently closed.
+func (c *Client) CircuitState() CircuitState {
+	if c.breaker == nil {
+		return CircuitClosed
+	}
+	return c.breaker.currentState()
+}
+
+// checkCircuit rejects the request when the circuit is open.
+func (c *Client) checkCircuit() error {
+	if c.breaker == nil {
+		return nil
+	}
+	retryAfter, ok := c.breaker.allow(time.Now())
+	if !ok {
+		c.logger.Warn("request rejected: circuit breaker open", "retryAfter", retryAfter.String())
+		return errors.NewCircuitOpenError(c.Lang(), retryAfter)
+	}
+	return nil
+}
+
+// recordCircuit feeds the request outcome into the breaker.
+func (c *Client) recordCircuit(err error) {
+	if c.breaker == nil {
+		return
+	}
+	c.breaker.record(err, time.Now())
+}
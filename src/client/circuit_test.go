@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func newBreakerClient(t *testing.T, handler http.HandlerFunc, threshold int, timeout time.Duration) (*Client, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	c := New("auth-key", "secret-key",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithCircuitBreaker(threshold, timeout))
+	return c, &calls
+}
+
+func TestCircuitBreakerOpensAfterThreshold(t *testing.T) {
+	c, calls := newBreakerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}, 2, time.Minute)
+	if got := c.CircuitState(); got != CircuitClosed {
+		t.Fatalf("initial state = %v, want closed", got)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err == nil {
+			t.Fatal("DoRequest returned nil error for 502 response")
+		}
+	}
+	if got := c.CircuitState(); got != CircuitOpen {
+		t.Fatalf("state after %d failures = %v, want open", 2, got)
+	}
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if !errors.Is(err, errors.ErrCircuitOpen) {
+		t.Fatalf("error = %v, want ErrCircuitOpen", err)
+	}
+	ce := errors.GetCircuitOpenError(err)
+	if ce == nil {
+		t.Fatal("error is not a *CircuitOpenError")
+	}
+	if ce.RetryAfter <= 0 || ce.RetryAfter > time.Minute {
+		t.Errorf("RetryAfter = %v, want within (0, 1m]", ce.RetryAfter)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("server received %d calls, want 2 (open circuit must not call out)", calls.Load())
+	}
+}
+
+func TestCircuitBreakerHalfOpenProbeSuccess(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	c, _ := newBreakerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}, 1, 10*time.Millisecond)
+
+	c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if got := c.CircuitState(); got != CircuitOpen {
+		t.Fatalf("state = %v, want open", got)
+	}
+	fail.Store(false)
+	time.Sleep(15 * time.Millisecond)
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("probe request failed: %v", err)
+	}
+	if got := c.CircuitState(); got != CircuitClosed {
+		t.Errorf("state after successful probe = %v, want closed", got)
+	}
+}
+
+func TestCircuitBreakerHalfOpenProbeFailure(t *testing.T) {
+	c, _ := newBreakerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}, 1, 10*time.Millisecond)
+
+	c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if got := c.CircuitState(); got != CircuitOpen {
+		t.Fatalf("state = %v, want open", got)
+	}
+	time.Sleep(15 * time.Millisecond)
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err == nil {
+		t.Fatal("probe request returned nil error for 502 response")
+	}
+	if got := c.CircuitState(); got != CircuitOpen {
+		t.Errorf("state after failed probe = %v, want open again", got)
+	}
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if !errors.Is(err, errors.ErrCircuitOpen) {
+		t.Errorf("error = %v, want ErrCircuitOpen", err)
+	}
+}
@@ -0,0 +1,233 @@
+// Package client implements the HTTP client shared by all GSPAY2 services:
+// request execution with retries, response parsing, request signing and
+// callback IP whitelisting.
+package client
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/internal/sanitize"
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+	"github.com/nikon1313/gspay-go-sdk/src/signature"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultBaseURL is the production GSPAY2 API origin.
+const DefaultBaseURL = "https://api.gspay2.com"
+
+// Default retry behaviour applied by New.
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultRetries      = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// Client is the shared transport for all GSPAY2 services. Construct it
+// with New and pass it to the service constructors. A Client is safe for
+// concurrent use.
+type Client struct {
+	// AuthKey is the operator auth key embedded in endpoint paths.
+	AuthKey string
+	// SecretKey signs requests and verifies callback signatures.
+	SecretKey string
+	// BaseURL is the API origin without a trailing slash.
+	BaseURL string
+	// HTTPClient executes the requests. It is shared, never copied.
+	HTTPClient *http.Client
+	// Retries is the number of retry attempts after the initial request.
+	Retries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between
+	// retry attempts.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// JitterFactor is the share of each backoff interval that is
+	// randomized, in [0.0, 1.0].
+	JitterFactor float64
+	// Language selects the language of error messages.
+	Language i18n.Language
+	// Debug disables log sanitization so full request data is visible.
+	Debug bool
+	// CallbackIPWhitelist lists IPs and CIDR ranges allowed to deliver
+	// callbacks. Empty means no IP filtering.
+	CallbackIPWhitelist []string
+
+	langMu              *sync.RWMutex
+	logger              logger.Handler
+	customLogger        bool
+	structuredLogger    bool
+	debugWriter         io.Writer
+	digest              signature.Digest
+	rateLimiter         RateLimiter
+	breaker             *circuitBreaker
+	requestIDGen        func() string
+	poolConfig          *ConnectionPoolConfig
+	proxy               func(*http.Request) (*url.URL, error)
+	proxyInvalid        string
+	tlsConfig           *tls.Config
+	tlsErr              error
+	authKeyValidator    func(string) error
+	secretKeyValidator  func(string) error
+	ctxHeaders          []ContextHeaderKey
+	cacheTTL            time.Duration
+	cacheSize           int
+	cache               *responseCache
+	strictBaseURL       bool
+	httpsOnly           *bool
+	configErr           error
+	idempotencyGen      func(method, endpoint string, body []byte) string
+	middlewares         []HTTPMiddleware
+	customHeaders       []customHeader
+	timeoutRules        []timeoutRule
+	drain               *drainState
+	limiter             *concurrencyLimiter
+	retryCondition      RetryCondition
+	bulkTimeout         time.Duration
+	responseTransformer ResponseTransformer
+	preprocessor        RequestPreprocessor
+	compression         CompressionAlgo
+	onRetry             func(attempt int, resp *http.Response, err error)
+	onComplete          func(method, endpoint string, statusCode int, duration time.Duration, err error)
+	auditLogger         func(RequestLog, ResponseLog)
+	metricsReg          prometheus.Registerer
+	metricsNamespace    string
+	metrics             *clientMetrics
+	http2               bool
+	customHTTPClient    bool
+	tracer              trace.Tracer
+	maxCallbackBody     int64
+	maxResponseBody     int64
+	parsedIPs           []net.IP
+	parsedIPNets        []*net.IPNet
+}
+
+// New returns a Client configured with the operator credentials and the
+// given options.
+func New(authKey, secretKey string, opts ...Option) *Client {
+	c := &Client{
+		AuthKey:         authKey,
+		SecretKey:       secretKey,
+		BaseURL:         DefaultBaseURL,
+		Retries:         DefaultRetries,
+		RetryWaitMin:    DefaultRetryWaitMin,
+		RetryWaitMax:    DefaultRetryWaitMax,
+		JitterFactor:    DefaultJitterFactor,
+		Language:        i18n.English,
+		langMu:          &sync.RWMutex{},
+		logger:          logger.NewNop(),
+		maxCallbackBody: DefaultMaxCallbackBodySize,
+		maxResponseBody: DefaultMaxResponseBodySize,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.applyDebugWriter()
+	c.applyKeyValidators()
+	c.applyBaseURLValidation()
+	c.applyHTTPSOnly()
+	c.applyContextPropagation()
+	c.applyCustomHeaders()
+	c.applyTransportOptions()
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	c.applyTLSConfig()
+	c.applyMiddleware()
+	c.applyMetrics()
+	c.applyCache()
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+	return c
+}
+
+// Logger returns the configured log handler.
+func (c *Client) Logger() logger.Handler { return c.logger }
+
+// BulkTimeout returns the configured bulk operation timeout; zero means
+// unbounded.
+func (c *Client) BulkTimeout() time.Duration { return c.bulkTimeout }
+
+// Lang returns the configured message language.
+func (c *Client) Lang() i18n.Language {
+	if c.langMu == nil {
+		return c.Language
+	}
+	c.langMu.RLock()
+	defer c.langMu.RUnlock()
+	return c.Language
+}
+
+// SetLanguage changes the message language at runtime. Invalid languages
+// are ignored. It is safe for concurrent use with in-flight requests.
+func (c *Client) SetLanguage(lang i18n.Language) {
+	if !lang.IsValid() {
+		return
+	}
+	if c.langMu == nil {
+		c.Language = lang
+		return
+	}
+	c.langMu.Lock()
+	c.Language = lang
+	c.langMu.Unlock()
+}
+
+// Endpoint returns the named endpoint path with the operator auth key
+// applied. Unknown names and patterns that fail to format yield an empty
+// path.
+func (c *Client) Endpoint(name string) string {
+	pattern := constants.GetEndpoint(name)
+	if pattern == "" {
+		return ""
+	}
+	path, err := pattern.Format(c.AuthKey)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// I18n returns the message for key in the client's language.
+func (c *Client) I18n(key i18n.MessageKey) string { return i18n.Get(c.Lang(), key) }
+
+// Error wraps a sentinel error with its localized message in the client's
+// language. Optional context values are appended to the message.
+func (c *Client) Error(sentinel error, context ...any) error {
+	return errors.New(c.Lang(), sentinel, context...)
+}
+
+// LogAccountNumber returns the account number as it should appear in logs:
+// masked in production, raw when Debug is enabled.
+func (c *Client) LogAccountNumber(s string) string {
+	if c.Debug {
+		return s
+	}
+	return sanitize.AccountNumber(s)
+}
+
+// LogAccountName returns the account holder name as it should appear in
+// logs: initials in production, raw when Debug is enabled.
+func (c *Client) LogAccountName(s string) string {
+	if c.Debug {
+		return s
+	}
+	return sanitize.AccountName(s)
+}
+
+// LogEndpoint returns the endpoint with the auth key redacted for logging.
+func (c *Client) LogEndpoint(s string) string {
+	if c.Debug {
+		return s
+	}
+	return sanitize.Endpoint(s)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestI18nFollowsSetLanguage(t *testing.T) {
+	c := New("auth", "secret")
+	if got := c.I18n(i18n.MsgSignatureMismatch); got != "signature verification failed" {
+		t.Errorf("default I18n = %q, want English message", got)
+	}
+
+	c.SetLanguage(i18n.Indonesian)
+	if got := c.I18n(i18n.MsgSignatureMismatch); got != "verifikasi tanda tangan gagal" {
+		t.Errorf("I18n after SetLanguage = %q, want Indonesian message", got)
+	}
+
+	c.SetLanguage("xx")
+	if c.Lang() != i18n.Indonesian {
+		t.Errorf("Lang after invalid SetLanguage = %v, want Indonesian", c.Lang())
+	}
+}
+
+func TestSetLanguageConcurrent(t *testing.T) {
+	c := New("auth", "secret")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.SetLanguage(i18n.Indonesian)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = c.I18n(i18n.MsgSignatureMismatch)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLoggerAccessor(t *testing.T) {
+	log := &mockLogger{}
+	c := New("auth", "secret", WithLogger(log))
+	if c.Logger() != log {
+		t.Error("Logger() does not return the configured handler")
+	}
+}
+
+func TestErrorWrapsSentinel(t *testing.T) {
+	c := New("auth", "secret")
+	err := c.Error(errors.ErrSignatureMismatch)
+	if !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Errorf("error = %v, does not wrap ErrSignatureMismatch", err)
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"net"
+	"sync"
+)
+
+// Clone returns a derived client: a copy of c with the given options
+// applied on top. It is intended for multi-tenant setups where tenants
+// share transport, retry and logging configuration but use their own
+// credentials:
+//
+//	tenant := base.Clone(client.WithLanguage(i18n.Indonesian))
+//
+// The HTTPClient is shared between the original and the clone, since
+// *http.Client is safe for concurrent use. Slices and the circuit
+// breaker state are fresh copies, so the clone and the original cannot
+// affect each other.
+func (c *Client) Clone(opts ...Option) *Client {
+	clone := *c
+	clone.langMu = &sync.RWMutex{}
+	clone.CallbackIPWhitelist = append([]string(nil), c.CallbackIPWhitelist...)
+	clone.parsedIPs = append([]net.IP(nil), c.parsedIPs...)
+	clone.parsedIPNets = append([]*net.IPNet(nil), c.parsedIPNets...)
+	if c.breaker != nil {
+		clone.breaker = &circuitBreaker{threshold: c.breaker.threshold, timeout: c.breaker.timeout}
+	}
+	if c.drain != nil {
+		clone.drain = &drainState{}
+	}
+	if c.limiter != nil {
+		clone.limiter = &concurrencyLimiter{sem: make(chan struct{}, cap(c.limiter.sem))}
+	}
+	for _, opt := range opts {
+		opt(&clone)
+	}
+	return &clone
+}
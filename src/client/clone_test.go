@@ -0,0 +1,63 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestCloneIsIndependent(t *testing.T) {
+	base := New("base-auth", "base-secret",
+		WithCallbackIPWhitelist([]string{"10.0.0.1"}))
+	clone := base.Clone(WithLanguage(i18n.Indonesian))
+
+	clone.AuthKey = "tenant-auth"
+	clone.SecretKey = "tenant-secret"
+	if base.AuthKey != "base-auth" || base.SecretKey != "base-secret" {
+		t.Error("changing the clone's credentials affected the original")
+	}
+	if base.Language != i18n.English {
+		t.Errorf("base language = %v, want English", base.Language)
+	}
+	if clone.Language != i18n.Indonesian {
+		t.Errorf("clone language = %v, want Indonesian", clone.Language)
+	}
+
+	clone.CallbackIPWhitelist[0] = "10.9.9.9"
+	if base.CallbackIPWhitelist[0] != "10.0.0.1" {
+		t.Error("clone shares the CallbackIPWhitelist backing array")
+	}
+}
+
+func TestCloneOverridesOnlyGivenOptions(t *testing.T) {
+	base := New("auth", "secret", WithRetries(5), WithBaseURL("https://sandbox.example"))
+	clone := base.Clone(WithRetries(0))
+	if clone.Retries != 0 {
+		t.Errorf("clone Retries = %d, want 0", clone.Retries)
+	}
+	if clone.BaseURL != "https://sandbox.example" {
+		t.Errorf("clone BaseURL = %q, want the base value", clone.BaseURL)
+	}
+}
+
+func TestCloneSharesHTTPClient(t *testing.T) {
+	base := New("auth", "secret")
+	clone := base.Clone()
+	if clone.HTTPClient != base.HTTPClient {
+		t.Error("HTTPClient is not shared with the clone")
+	}
+}
+
+func TestCloneParsesFreshWhitelist(t *testing.T) {
+	base := New("auth", "secret", WithCallbackIPWhitelist([]string{"10.0.0.1"}))
+	clone := base.Clone(WithCallbackIPWhitelist([]string{"192.168.1.0/24"}))
+	if !clone.IsIPWhitelisted("192.168.1.7") {
+		t.Error("clone does not whitelist its own CIDR range")
+	}
+	if clone.IsIPWhitelisted("10.0.0.1") {
+		t.Error("clone still whitelists the original's IP")
+	}
+	if !base.IsIPWhitelisted("10.0.0.1") {
+		t.Error("original whitelist was disturbed by the clone")
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/gc"
+)
+
+// CompressionAlgo selects how outgoing request bodies are compressed. The
+// value doubles as the Content-Encoding header sent with the request.
+type CompressionAlgo string
+
+const (
+	// CompressionNone sends request bodies uncompressed. The default.
+	CompressionNone CompressionAlgo = ""
+	// CompressionGzip compresses request bodies with gzip.
+	CompressionGzip CompressionAlgo = "gzip"
+	// CompressionDeflate compresses request bodies with DEFLATE.
+	CompressionDeflate CompressionAlgo = "deflate"
+)
+
+// WithRequestBodyCompression compresses outgoing JSON request bodies with
+// the given algorithm and sets the matching Content-Encoding header, which
+// helps with large payloads such as payout batches. The body is compressed
+// once per logical request, so every retry attempt resends the same bytes.
+func WithRequestBodyCompression(algo CompressionAlgo) Option {
+	return func(c *Client) { c.compression = algo }
+}
+
+// compressPayload compresses the marshaled body with the configured
+// algorithm, borrowing an output buffer from the shared pool.
+func (c *Client) compressPayload(payload []byte) ([]byte, error) {
+	buf := gc.Default.Get()
+	defer gc.Default.Put(buf)
+	var w io.WriteCloser
+	switch c.compression {
+	case CompressionGzip:
+		w = gzip.NewWriter(buf)
+	case CompressionDeflate:
+		var err error
+		if w, err = flate.NewWriter(buf, flate.DefaultCompression); err != nil {
+			return nil, c.Error(errors.ErrRequestFailed, err)
+		}
+	default:
+		return nil, c.Error(errors.ErrRequestFailed, fmt.Sprintf("unsupported compression algorithm %q", c.compression))
+	}
+	if _, err := w.Write(payload); err != nil {
+		return nil, c.Error(errors.ErrRequestFailed, err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, c.Error(errors.ErrRequestFailed, err)
+	}
+	return append([]byte(nil), buf.Bytes()...), nil
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// largeBody builds a request payload big enough for compression to pay off.
+func largeBody() map[string]string {
+	return map[string]string{"notes": strings.Repeat("repetitive payout remark ", 400)}
+}
+
+func TestRequestBodyCompressionGzip(t *testing.T) {
+	var encoding string
+	var bodySize int
+	var decoded map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding = r.Header.Get("Content-Encoding")
+		raw, _ := io.ReadAll(r.Body)
+		bodySize = len(raw)
+		gz, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			t.Errorf("gzip.NewReader: %v", err)
+		} else {
+			plain, _ := io.ReadAll(gz)
+			json.Unmarshal(plain, &decoded)
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0),
+		WithRequestBodyCompression(CompressionGzip))
+	body := largeBody()
+	var out struct{}
+	if err := c.Post(context.Background(), "/probe", body, &out); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	if encoding != "gzip" {
+		t.Errorf("Content-Encoding = %q, want gzip", encoding)
+	}
+	plain, _ := json.Marshal(body)
+	if bodySize >= len(plain) {
+		t.Errorf("compressed size %d is not smaller than plain size %d", bodySize, len(plain))
+	}
+	if decoded["notes"] != body["notes"] {
+		t.Error("server did not recover the original payload")
+	}
+}
+
+func TestRequestBodyCompressionDeflate(t *testing.T) {
+	var decoded map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "deflate" {
+			t.Errorf("Content-Encoding = %q, want deflate", got)
+		}
+		fr := flate.NewReader(r.Body)
+		plain, _ := io.ReadAll(fr)
+		fr.Close()
+		json.Unmarshal(plain, &decoded)
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0),
+		WithRequestBodyCompression(CompressionDeflate))
+	body := largeBody()
+	var out struct{}
+	if err := c.Post(context.Background(), "/probe", body, &out); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	if decoded["notes"] != body["notes"] {
+		t.Error("server did not recover the original payload")
+	}
+}
+
+func TestRequestBodyCompressionResendsSameBytesOnRetry(t *testing.T) {
+	var attempts atomic.Int64
+	var bodies [][]byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, raw)
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithRetries(1), WithRetryWait(time.Millisecond, time.Millisecond),
+		WithRequestBodyCompression(CompressionGzip))
+	var out struct{}
+	if err := c.Post(context.Background(), "/probe", largeBody(), &out); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("server saw %d requests, want 2", len(bodies))
+	}
+	if !bytes.Equal(bodies[0], bodies[1]) {
+		t.Error("retry sent different compressed bytes")
+	}
+}
+
+func TestRequestBodyCompressionNoneByDefault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "" {
+			t.Errorf("Content-Encoding = %q, want unset", got)
+		}
+		raw, _ := io.ReadAll(r.Body)
+		if !json.Valid(raw) {
+			t.Error("default body is not plain JSON")
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0))
+	var out struct{}
+	if err := c.Post(context.Background(), "/probe", largeBody(), &out); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Default environment variables read by WithCredentialsFromEnv.
+const (
+	DefaultAuthKeyEnv   = "GSPAY_AUTH_KEY"
+	DefaultSecretKeyEnv = "GSPAY_SECRET_KEY"
+)
+
+// ErrMissingCredential reports a credential-loading option that found no
+// usable value. It is stored as the client's configuration error and
+// returned from the first request, before any network traffic.
+type ErrMissingCredential struct {
+	// EnvVar is the environment variable that was unset or empty.
+	EnvVar string
+	// Path is the credentials file that could not be used, for
+	// WithCredentialsFromFile.
+	Path string
+}
+
+// Error implements the error interface.
+func (e *ErrMissingCredential) Error() string {
+	if e.EnvVar != "" {
+		return fmt.Sprintf("gspay: credential environment variable %s is not set", e.EnvVar)
+	}
+	return fmt.Sprintf("gspay: credentials file %s is missing a key", e.Path)
+}
+
+// WithAuthKeyFromEnv reads the auth key from the named environment
+// variable, overriding the value passed to New. An unset or empty
+// variable becomes the client's configuration error.
+func WithAuthKeyFromEnv(envVar string) Option {
+	return func(c *Client) {
+		value := os.Getenv(envVar)
+		if value == "" {
+			if c.configErr == nil {
+				c.configErr = &ErrMissingCredential{EnvVar: envVar}
+			}
+			return
+		}
+		c.AuthKey = value
+	}
+}
+
+// WithSecretKeyFromEnv reads the secret key from the named environment
+// variable, with the same failure behaviour as WithAuthKeyFromEnv.
+func WithSecretKeyFromEnv(envVar string) Option {
+	return func(c *Client) {
+		value := os.Getenv(envVar)
+		if value == "" {
+			if c.configErr == nil {
+				c.configErr = &ErrMissingCredential{EnvVar: envVar}
+			}
+			return
+		}
+		c.SecretKey = value
+	}
+}
+
+// WithCredentialsFromEnv reads both keys from the default environment
+// variables GSPAY_AUTH_KEY and GSPAY_SECRET_KEY.
+func WithCredentialsFromEnv() Option {
+	return func(c *Client) {
+		WithAuthKeyFromEnv(DefaultAuthKeyEnv)(c)
+		WithSecretKeyFromEnv(DefaultSecretKeyEnv)(c)
+	}
+}
+
+// credentialsFile is the on-disk JSON shape read by
+// WithCredentialsFromFile.
+type credentialsFile struct {
+	AuthKey   string `json:"auth_key"`
+	SecretKey string `json:"secret_key"`
+}
+
+// WithCredentialsFromFile reads both keys from a JSON file of the form
+// {"auth_key":"...","secret_key":"..."}. The file buffer is zeroed after
+// parsing so the raw bytes do not linger in memory; the parsed strings
+// live exactly as long as the client. Read or parse failures, and files
+// missing either key, become the client's configuration error.
+func WithCredentialsFromFile(path string) Option {
+	return func(c *Client) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if c.configErr == nil {
+				c.configErr = fmt.Errorf("gspay: reading credentials file: %w", err)
+			}
+			return
+		}
+		var creds credentialsFile
+		err = json.Unmarshal(data, &creds)
+		for i := range data {
+			data[i] = 0
+		}
+		if err != nil {
+			if c.configErr == nil {
+				c.configErr = fmt.Errorf("gspay: parsing credentials file %s: %w", path, err)
+			}
+			return
+		}
+		if creds.AuthKey == "" || creds.SecretKey == "" {
+			if c.configErr == nil {
+				c.configErr = &ErrMissingCredential{Path: path}
+			}
+			return
+		}
+		c.AuthKey = creds.AuthKey
+		c.SecretKey = creds.SecretKey
+	}
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWithCredentialsFromEnv(t *testing.T) {
+	t.Setenv(DefaultAuthKeyEnv, "env-auth")
+	t.Setenv(DefaultSecretKeyEnv, "env-secret")
+	c := New("", "", WithCredentialsFromEnv())
+	if c.AuthKey != "env-auth" || c.SecretKey != "env-secret" {
+		t.Errorf("keys = %q/%q, want the environment values", c.AuthKey, c.SecretKey)
+	}
+}
+
+func TestWithAuthKeyFromEnvMissing(t *testing.T) {
+	t.Setenv("GSPAY_TEST_MISSING", "")
+	c := New("", "secret", WithAuthKeyFromEnv("GSPAY_TEST_MISSING"))
+	var out struct{}
+	err := c.Get(context.Background(), "/probe", &out)
+	if err == nil {
+		t.Fatal("Get succeeded, want the missing credential error")
+	}
+	mc, ok := err.(*ErrMissingCredential)
+	if !ok {
+		t.Fatalf("error = %T, want *ErrMissingCredential", err)
+	}
+	if mc.EnvVar != "GSPAY_TEST_MISSING" || !strings.Contains(err.Error(), "GSPAY_TEST_MISSING") {
+		t.Errorf("error = %v, want the variable named", err)
+	}
+}
+
+func TestWithSecretKeyFromEnvOverrides(t *testing.T) {
+	t.Setenv("GSPAY_TEST_SECRET", "from-env")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	c := New("auth", "from-new", WithBaseURL(srv.URL), WithSecretKeyFromEnv("GSPAY_TEST_SECRET"))
+	if c.SecretKey != "from-env" {
+		t.Errorf("SecretKey = %q, want from-env", c.SecretKey)
+	}
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+}
+
+func TestWithCredentialsFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.json")
+	if err := os.WriteFile(path, []byte(`{"auth_key":"file-auth","secret_key":"file-secret"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := New("", "", WithCredentialsFromFile(path))
+	if c.AuthKey != "file-auth" || c.SecretKey != "file-secret" {
+		t.Errorf("keys = %q/%q, want the file values", c.AuthKey, c.SecretKey)
+	}
+}
+
+func TestWithCredentialsFromFileMissingKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.json")
+	if err := os.WriteFile(path, []byte(`{"auth_key":"file-auth"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := New("", "", WithCredentialsFromFile(path))
+	var out struct{}
+	err := c.Get(context.Background(), "/probe", &out)
+	if _, ok := err.(*ErrMissingCredential); !ok {
+		t.Fatalf("error = %v (%T), want *ErrMissingCredential", err, err)
+	}
+}
+
+func TestWithCredentialsFromFileUnreadable(t *testing.T) {
+	c := New("", "", WithCredentialsFromFile(filepath.Join(t.TempDir(), "absent.json")))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err == nil {
+		t.Fatal("Get succeeded, want a read error for the absent file")
+	}
+}
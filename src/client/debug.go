@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+)
+
+// WithDebugWriter routes SDK log output, including debug messages, to w
+// using a plain-text logger. It takes precedence over WithLogger; when
+// both are supplied a warning is emitted to w.
+func WithDebugWriter(w io.Writer) Option {
+	return func(c *Client) { c.debugWriter = w }
+}
+
+// applyDebugWriter resolves WithDebugWriter after all options have run,
+// so the outcome does not depend on option order.
+func (c *Client) applyDebugWriter() {
+	if c.debugWriter == nil {
+		return
+	}
+	replaced := c.customLogger
+	c.logger = logger.NewStd(c.debugWriter, logger.LevelDebug)
+	if replaced {
+		c.logger.Warn(c.I18n(i18n.LogDebugWriterOverride))
+	}
+}
+
+// syncBuffer is an in-memory io.ReadWriter safe for concurrent use, so
+// CaptureDebugOutput never blocks the client goroutine.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Read(p)
+}
+
+// CaptureDebugOutput redirects the client's log output to an in-memory
+// buffer at debug level and returns the read end together with a cleanup
+// function that restores the previous logger. It is intended for tests.
+func CaptureDebugOutput(c *Client) (io.Reader, func()) {
+	buf := &syncBuffer{}
+	prev := c.logger
+	c.logger = logger.NewStd(buf, logger.LevelDebug)
+	return buf, func() { c.logger = prev }
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWithDebugWriter(t *testing.T) {
+	var buf bytes.Buffer
+	c := New("auth", "secret", WithDebugWriter(&buf))
+	c.Logger().Debug("probe", "key", "value")
+	if !strings.Contains(buf.String(), "probe") {
+		t.Errorf("debug output = %q, want debug message included", buf.String())
+	}
+}
+
+func TestWithDebugWriterOverridesLogger(t *testing.T) {
+	var buf bytes.Buffer
+	ml := &mockLogger{}
+	c := New("auth", "secret", WithLogger(ml), WithDebugWriter(&buf))
+	c.Logger().Info("probe")
+	if !strings.Contains(buf.String(), "probe") {
+		t.Errorf("debug output = %q, want log routed to writer", buf.String())
+	}
+	if !strings.Contains(buf.String(), "debug writer") {
+		t.Errorf("debug output = %q, want override warning", buf.String())
+	}
+	if len(ml.entries) != 0 {
+		t.Errorf("custom logger received %d entries, want 0", len(ml.entries))
+	}
+}
+
+func TestCaptureDebugOutputSanitizes(t *testing.T) {
+	c := New("auth", "secret")
+	out, cleanup := CaptureDebugOutput(c)
+	c.Logger().Debug("creating payout", "accountNumber", c.LogAccountNumber("1234567890"))
+	cleanup()
+	data, err := io.ReadAll(out)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if !strings.Contains(string(data), "****7890") {
+		t.Errorf("output = %q, want masked account number", data)
+	}
+	if strings.Contains(string(data), "1234567890") {
+		t.Errorf("output = %q, want raw account number absent", data)
+	}
+}
+
+func TestCaptureDebugOutputRestoresLogger(t *testing.T) {
+	ml := &mockLogger{}
+	c := New("auth", "secret", WithLogger(ml))
+	_, cleanup := CaptureDebugOutput(c)
+	cleanup()
+	c.Logger().Info("after cleanup")
+	if len(ml.entries) != 1 {
+		t.Errorf("restored logger received %d entries, want 1", len(ml.entries))
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Doer is the client surface the services depend on. The service
+// constructors accept a Doer instead of a *Client so applications can
+// substitute a fake in tests. *Client is the canonical implementation.
+type Doer interface {
+	// Post sends a JSON body and decodes the response Data payload.
+	Post(ctx context.Context, endpoint string, body, out any) error
+	// PostWithHeaders behaves like Post with additional request headers,
+	// returning the response envelope.
+	PostWithHeaders(ctx context.Context, endpoint string, body, out any, headers http.Header) (*Response, error)
+	// Get requests the endpoint and decodes the response Data payload.
+	Get(ctx context.Context, endpoint string, out any) error
+	// DoRequest executes one API call and returns the response envelope.
+	DoRequest(ctx context.Context, method, endpoint string, body any) (*Response, error)
+	// Ping verifies connectivity and credentials with a short timeout.
+	Ping(ctx context.Context) error
+	// GenerateSignature signs the concatenated values with the secret key.
+	GenerateSignature(values ...string) string
+	// VerifySignature checks got against the signature of the values.
+	VerifySignature(got string, values ...string) bool
+	// VerifyCallbackIP checks a callback source address against the whitelist.
+	VerifyCallbackIP(remoteAddr string) error
+	// IsIPWhitelisted reports whether the IP passes the whitelist.
+	IsIPWhitelisted(ipStr string) bool
+	// Endpoint returns the named endpoint path with the auth key applied.
+	Endpoint(name string) string
+	// Lang returns the configured message language.
+	Lang() i18n.Language
+	// I18n returns the message for key in the configured language.
+	I18n(key i18n.MessageKey) string
+	// Error wraps a sentinel error with its localized message.
+	Error(sentinel error, context ...any) error
+	// Logger returns the configured log handler.
+	Logger() logger.Handler
+	// BulkTimeout returns the configured bulk operation timeout.
+	BulkTimeout() time.Duration
+	// LogAccountNumber masks an account number for logging.
+	LogAccountNumber(s string) string
+	// LogAccountName masks an account holder name for logging.
+	LogAccountName(s string) string
+	// LogEndpoint redacts the auth key in an endpoint for logging.
+	LogEndpoint(s string) string
+	// StartSpan opens a tracing span when tracing is enabled.
+	StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span)
+	// ParseCallbackBody validates and decodes an incoming webhook request.
+	ParseCallbackBody(r *http.Request, out any) error
+}
+
+var _ Doer = (*Client)(nil)
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// drainState tracks in-flight requests for graceful shutdown. It lives
+// behind a pointer so Client stays copyable for Clone.
+type drainState struct {
+	draining atomic.Bool
+	inFlight sync.WaitGroup
+	count    atomic.Int64
+}
+
+// WithGracefulShutdown makes the client track in-flight requests so a
+// shutting-down server can wait for them with Drain. Tracking is off by
+// default because it adds a small amount of synchronization per request.
+func WithGracefulShutdown() Option {
+	return func(c *Client) { c.drain = &drainState{} }
+}
+
+// Drain marks the client as draining — new requests fail with
+// errors.ErrClientDraining — and waits until all in-flight requests have
+// completed or ctx expires, in which case it returns ctx.Err(). It is a
+// no-op without WithGracefulShutdown.
+func (c *Client) Drain(ctx context.Context) error {
+	if c.drain == nil {
+		return nil
+	}
+	c.drain.draining.Store(true)
+	done := make(chan struct{})
+	go func() {
+		c.drain.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return nil
+	}
+}
+
+// InFlightCount returns the number of requests currently executing. It is
+// always zero without WithGracefulShutdown.
+func (c *Client) InFlightCount() int {
+	if c.drain == nil {
+		return 0
+	}
+	return int(c.drain.count.Load())
+}
+
+// acquireInFlight registers one request with the drain tracking. It
+// returns an error when the client is draining, and a release function
+// otherwise. Without WithGracefulShutdown it is free of synchronization.
+func (c *Client) acquireInFlight() (func(), error) {
+	if c.drain == nil {
+		return func() {}, nil
+	}
+	if c.drain.draining.Load() {
+		return nil, c.Error(errors.ErrClientDraining)
+	}
+	c.drain.inFlight.Add(1)
+	c.drain.count.Add(1)
+	return func() {
+		c.drain.count.Add(-1)
+		c.drain.inFlight.Done()
+	}, nil
+}
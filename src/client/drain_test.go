@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestDrainWaitsForInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithGracefulShutdown())
+	requestDone := make(chan error, 1)
+	go func() {
+		var out struct{}
+		requestDone <- c.Get(context.Background(), "/probe", &out)
+	}()
+
+	for c.InFlightCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	drainDone := make(chan error, 1)
+	go func() { drainDone <- c.Drain(context.Background()) }()
+
+	select {
+	case err := <-drainDone:
+		t.Fatalf("Drain returned %v before the in-flight request completed", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-requestDone; err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if err := <-drainDone; err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if got := c.InFlightCount(); got != 0 {
+		t.Errorf("InFlightCount after drain = %d, want 0", got)
+	}
+}
+
+func TestDrainReturnsContextError(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithGracefulShutdown())
+	go func() {
+		var out struct{}
+		c.Get(context.Background(), "/probe", &out)
+	}()
+	for c.InFlightCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := c.Drain(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Drain error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestDrainRejectsNewRequests(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithGracefulShutdown())
+	go func() {
+		var out struct{}
+		c.Get(context.Background(), "/probe", &out)
+	}()
+	for c.InFlightCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	go c.Drain(context.Background())
+	for !c.drain.draining.Load() {
+		time.Sleep(time.Millisecond)
+	}
+
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); !errors.Is(err, errors.ErrClientDraining) {
+		t.Errorf("Get while draining error = %v, want ErrClientDraining", err)
+	}
+	close(release)
+}
+
+func TestInFlightCountDuringConcurrentRequests(t *testing.T) {
+	const workers = 4
+	release := make(chan struct{})
+	arrived := make(chan struct{}, workers)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithGracefulShutdown())
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{}
+			c.Get(context.Background(), "/probe", &out)
+		}()
+	}
+	for i := 0; i < workers; i++ {
+		<-arrived
+	}
+	if got := c.InFlightCount(); got != workers {
+		t.Errorf("InFlightCount = %d, want %d", got, workers)
+	}
+	close(release)
+	wg.Wait()
+	if got := c.InFlightCount(); got != 0 {
+		t.Errorf("InFlightCount after completion = %d, want 0", got)
+	}
+}
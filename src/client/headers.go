@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// reservedHeaders are the headers the client manages itself; overriding
+// them with WithCustomHeader is a configuration error.
+var reservedHeaders = map[string]bool{
+	"Authorization": true,
+	"Content-Type":  true,
+	"Accept":        true,
+	"User-Agent":    true,
+}
+
+// customHeader is one static header configured with WithCustomHeader.
+type customHeader struct {
+	key   string
+	value string
+}
+
+// WithCustomHeader adds a static header to every outgoing request, for
+// API gateway setups that require extra metadata such as
+// "X-Operator-Region". Multiple calls accumulate. Invalid header names
+// and attempts to override the headers the client manages itself
+// (Authorization, Content-Type, Accept, User-Agent) surface as
+// errors.ErrReservedHeader or errors.ErrInvalidConfiguration from the
+// first request.
+func WithCustomHeader(key, value string) Option {
+	return func(c *Client) {
+		c.customHeaders = append(c.customHeaders, customHeader{key: key, value: value})
+	}
+}
+
+// applyCustomHeaders validates the configured headers after all options
+// have been applied.
+func (c *Client) applyCustomHeaders() {
+	for _, h := range c.customHeaders {
+		if !headerNameRe.MatchString(h.key) {
+			if c.configErr == nil {
+				c.configErr = c.Error(errors.ErrInvalidConfiguration, fmt.Sprintf("custom header %q", h.key))
+			}
+			continue
+		}
+		if reservedHeaders[http.CanonicalHeaderKey(h.key)] {
+			if c.configErr == nil {
+				c.configErr = c.Error(errors.ErrReservedHeader, h.key)
+			}
+		}
+	}
+}
+
+// setCustomHeaders sets the configured static headers on an outgoing
+// request.
+func (c *Client) setCustomHeaders(set func(key, value string)) {
+	for _, h := range c.customHeaders {
+		if !headerNameRe.MatchString(h.key) || reservedHeaders[http.CanonicalHeaderKey(h.key)] {
+			continue
+		}
+		set(h.key, h.value)
+	}
+}
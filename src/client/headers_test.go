@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestWithCustomHeaderSetsHeaders(t *testing.T) {
+	var gotRegion, gotVersion string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRegion = r.Header.Get("X-Operator-Region")
+		gotVersion = r.Header.Get("X-API-Version")
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithCustomHeader("X-Operator-Region", "sg"),
+		WithCustomHeader("X-API-Version", "2"))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if gotRegion != "sg" {
+		t.Errorf("X-Operator-Region = %q, want sg", gotRegion)
+	}
+	if gotVersion != "2" {
+		t.Errorf("X-API-Version = %q, want 2", gotVersion)
+	}
+}
+
+func TestWithCustomHeaderRejectsReserved(t *testing.T) {
+	for _, key := range []string{"Authorization", "content-type", "Accept", "user-agent"} {
+		c := New("auth", "secret", WithCustomHeader(key, "x"))
+		var out struct{}
+		if err := c.Get(context.Background(), "/probe", &out); !errors.Is(err, errors.ErrReservedHeader) {
+			t.Errorf("header %q: Get error = %v, want ErrReservedHeader", key, err)
+		}
+	}
+}
+
+func TestWithCustomHeaderRejectsInvalidName(t *testing.T) {
+	c := New("auth", "secret", WithCustomHeader("X-Bad Header\n", "x"))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); !errors.Is(err, errors.ErrInvalidConfiguration) {
+		t.Errorf("Get error = %v, want ErrInvalidConfiguration", err)
+	}
+}
+
+func TestWithCustomHeaderComposesWithMiddleware(t *testing.T) {
+	var seenByMiddleware, seenByServer string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenByServer = r.Header.Get("X-Operator-Region")
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	mw := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			seenByMiddleware = req.Header.Get("X-Operator-Region")
+			return next.RoundTrip(req)
+		})
+	}
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithCustomHeader("X-Operator-Region", "sg"),
+		WithHTTPTransportMiddleware(mw))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if seenByMiddleware != "sg" || seenByServer != "sg" {
+		t.Errorf("header seen by middleware %q / server %q, want sg for both", seenByMiddleware, seenByServer)
+	}
+}
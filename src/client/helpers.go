@@ -0,0 +1,122 @@
+package client
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	mrand "math/rand"
+	"strings"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// transactionIDTimeFormat is YmdHis, matching the GSPAY2 examples.
+const transactionIDTimeFormat = "20060102150405"
+
+// GenerateTransactionID returns a transaction ID of the form
+// prefix + YmdHis + three random digits, truncating the prefix when needed
+// to stay within MaxTransactionIDLength.
+func GenerateTransactionID(prefix string) string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000))
+	suffix := 0
+	if err == nil {
+		suffix = int(n.Int64())
+	}
+	return GenerateTransactionIDWithTime(prefix, time.Now(), suffix)
+}
+
+// GenerateTransactionIDWithTime is GenerateTransactionID with an explicit
+// timestamp and suffix, for code that needs predictable IDs.
+func GenerateTransactionIDWithTime(prefix string, t time.Time, suffix int) string {
+	ts := t.Format(transactionIDTimeFormat)
+	maxPrefix := constants.MaxTransactionIDLength - len(ts) - 3
+	if len(prefix) > maxPrefix {
+		prefix = prefix[:maxPrefix]
+	}
+	return fmt.Sprintf("%s%s%03d", prefix, ts, suffix%1000)
+}
+
+// GenerateTransactionIDFromSeed generates a fully deterministic
+// transaction ID from a seed, for test fixtures. The timestamp and the
+// suffix are both derived from the seeded source.
+func GenerateTransactionIDFromSeed(prefix string, seed int64) string {
+	r := mrand.New(mrand.NewSource(seed))
+	t := time.Unix(r.Int63n(1<<32), 0).UTC()
+	return GenerateTransactionIDWithTime(prefix, t, r.Intn(1000))
+}
+
+// Pre-defined alphabets for GenerateTransactionIDAlphabet.
+const (
+	// AlphanumericAlphabet is uppercase letters and digits without I and
+	// O, which read like 1 and 0.
+	AlphanumericAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ0123456789"
+	// HexAlphabet is lowercase hexadecimal.
+	HexAlphabet = "0123456789abcdef"
+)
+
+// GenerateTransactionIDAlphabet returns a transaction ID of the form
+// prefix + length cryptographically random characters drawn uniformly
+// from alphabet. The prefix is truncated when prefix and random part
+// together would exceed MaxTransactionIDLength. The alphabet must be
+// non-empty with no duplicate characters, and length must fit within
+// MaxTransactionIDLength on its own.
+func GenerateTransactionIDAlphabet(prefix, alphabet string, length int) (string, error) {
+	if alphabet == "" {
+		return "", errors.New(i18n.English, errors.ErrInvalidConfiguration, "alphabet is empty")
+	}
+	seen := map[rune]bool{}
+	for _, r := range alphabet {
+		if seen[r] {
+			return "", errors.New(i18n.English, errors.ErrInvalidConfiguration, fmt.Sprintf("alphabet has duplicate character %q", r))
+		}
+		seen[r] = true
+	}
+	if length <= 0 || length > constants.MaxTransactionIDLength {
+		return "", errors.New(i18n.English, errors.ErrInvalidConfiguration, fmt.Sprintf("length %d out of range", length))
+	}
+	if maxPrefix := constants.MaxTransactionIDLength - length; len(prefix) > maxPrefix {
+		prefix = prefix[:maxPrefix]
+	}
+	chars := []rune(alphabet)
+	max := big.NewInt(int64(len(chars)))
+	var b strings.Builder
+	b.WriteString(prefix)
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.New(i18n.English, errors.ErrRequestFailed, err)
+		}
+		b.WriteRune(chars[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// FormatAmountIDR renders a Rupiah amount for display, e.g.
+// "Rp 1.234.567,89".
+func FormatAmountIDR(amount float64) string {
+	s := fmt.Sprintf("%.2f", amount)
+	parts := strings.SplitN(s, ".", 2)
+	intPart, decPart := parts[0], parts[1]
+	neg := strings.HasPrefix(intPart, "-")
+	intPart = strings.TrimPrefix(intPart, "-")
+	var b strings.Builder
+	for i, d := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(d)
+	}
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	return fmt.Sprintf("Rp %s%s,%s", sign, b.String(), decPart)
+}
+
+// FormatAmountUSDT renders a USDT amount for display, e.g. "12.50 USDT".
+func FormatAmountUSDT(amount float64) string {
+	return fmt.Sprintf("%.2f USDT", amount)
+}
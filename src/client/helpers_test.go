@@ -0,0 +1,128 @@
+package client
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+func TestGenerateTransactionIDWithTime(t *testing.T) {
+	ts := time.Date(2026, 1, 26, 15, 4, 5, 0, time.UTC)
+	got := GenerateTransactionIDWithTime("TXN", ts, 42)
+	if got != "TXN20260126150405042" {
+		t.Errorf("GenerateTransactionIDWithTime = %q, want TXN20260126150405042", got)
+	}
+	if len(got) > constants.MaxTransactionIDLength {
+		t.Errorf("length = %d, exceeds %d", len(got), constants.MaxTransactionIDLength)
+	}
+}
+
+func TestGenerateTransactionIDWithTimeTruncatesPrefix(t *testing.T) {
+	ts := time.Date(2026, 1, 26, 15, 4, 5, 0, time.UTC)
+	got := GenerateTransactionIDWithTime("VERYLONGPREFIX", ts, 7)
+	if len(got) != constants.MaxTransactionIDLength {
+		t.Errorf("length = %d, want %d", len(got), constants.MaxTransactionIDLength)
+	}
+	if got[:3] != "VER" {
+		t.Errorf("prefix = %q, want VER", got[:3])
+	}
+}
+
+func TestGenerateTransactionIDFromSeed(t *testing.T) {
+	a := GenerateTransactionIDFromSeed("TXN", 1)
+	b := GenerateTransactionIDFromSeed("TXN", 1)
+	if a != b {
+		t.Errorf("same seed produced %q and %q", a, b)
+	}
+	if c := GenerateTransactionIDFromSeed("TXN", 2); c == a {
+		t.Errorf("different seeds produced the same ID %q", c)
+	}
+	if len(a) > constants.MaxTransactionIDLength {
+		t.Errorf("length = %d, exceeds %d", len(a), constants.MaxTransactionIDLength)
+	}
+}
+
+func TestGenerateTransactionIDLength(t *testing.T) {
+	got := GenerateTransactionID("TXN")
+	if len(got) > constants.MaxTransactionIDLength {
+		t.Errorf("length = %d, exceeds %d", len(got), constants.MaxTransactionIDLength)
+	}
+}
+
+func TestGenerateTransactionIDAlphabet(t *testing.T) {
+	id, err := GenerateTransactionIDAlphabet("INV", HexAlphabet, 12)
+	if err != nil {
+		t.Fatalf("GenerateTransactionIDAlphabet: %v", err)
+	}
+	if len(id) != 15 {
+		t.Errorf("len = %d, want 15", len(id))
+	}
+	if !strings.HasPrefix(id, "INV") {
+		t.Errorf("id = %q, want INV prefix", id)
+	}
+	for _, r := range id[3:] {
+		if !strings.ContainsRune(HexAlphabet, r) {
+			t.Errorf("id %q contains %q outside the alphabet", id, r)
+		}
+	}
+}
+
+func TestGenerateTransactionIDAlphabetValidation(t *testing.T) {
+	if _, err := GenerateTransactionIDAlphabet("INV", "", 10); err == nil {
+		t.Error("empty alphabet: want error, got nil")
+	}
+	if _, err := GenerateTransactionIDAlphabet("INV", "AAB", 10); err == nil {
+		t.Error("duplicate characters: want error, got nil")
+	}
+	if _, err := GenerateTransactionIDAlphabet("INV", HexAlphabet, 0); err == nil {
+		t.Error("zero length: want error, got nil")
+	}
+	if _, err := GenerateTransactionIDAlphabet("INV", HexAlphabet, constants.MaxTransactionIDLength+1); err == nil {
+		t.Error("oversized length: want error, got nil")
+	}
+}
+
+func TestGenerateTransactionIDAlphabetTruncatesPrefix(t *testing.T) {
+	prefix := strings.Repeat("P", constants.MaxTransactionIDLength)
+	id, err := GenerateTransactionIDAlphabet(prefix, HexAlphabet, 8)
+	if err != nil {
+		t.Fatalf("GenerateTransactionIDAlphabet: %v", err)
+	}
+	if len(id) != constants.MaxTransactionIDLength {
+		t.Errorf("len = %d, want %d", len(id), constants.MaxTransactionIDLength)
+	}
+}
+
+// TestGenerateTransactionIDAlphabetDistribution runs a chi-squared test
+// over the generated characters; a biased generator would skew signatures
+// toward predictable IDs.
+func TestGenerateTransactionIDAlphabetDistribution(t *testing.T) {
+	const samples = 10000
+	counts := map[rune]int{}
+	for i := 0; i < samples/10; i++ {
+		id, err := GenerateTransactionIDAlphabet("", AlphanumericAlphabet, 10)
+		if err != nil {
+			t.Fatalf("GenerateTransactionIDAlphabet: %v", err)
+		}
+		for _, r := range id {
+			counts[r]++
+		}
+	}
+	k := float64(len(AlphanumericAlphabet))
+	expected := float64(samples) / k
+	var chi2 float64
+	for _, r := range AlphanumericAlphabet {
+		diff := float64(counts[r]) - expected
+		chi2 += diff * diff / expected
+	}
+	// Mean of the chi-squared statistic is k-1; allow five standard
+	// deviations to keep the test deterministic in practice.
+	dof := k - 1
+	limit := dof + 5*math.Sqrt(2*dof)
+	if chi2 > limit {
+		t.Errorf("chi-squared = %.1f, want below %.1f", chi2, limit)
+	}
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+)
+
+// idempotencyKeyHeader carries the key that lets GSPAY2 deduplicate a
+// request that is retried after a lost response.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKeyGenerator derives an Idempotency-Key header for every
+// mutating request. The key is computed once per logical operation and
+// reused across retry attempts, so a request retried after a lost
+// response cannot create a duplicate order. GET requests carry no key.
+func WithIdempotencyKeyGenerator(gen func(method, endpoint string, body []byte) string) Option {
+	return func(c *Client) { c.idempotencyGen = gen }
+}
+
+// ContentHashIdempotencyGenerator is the default key derivation for
+// WithIdempotencyKeyGenerator: the hex SHA-256 of method, endpoint and
+// body, so identical operations map to identical keys.
+func ContentHashIdempotencyGenerator(method, endpoint string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte(endpoint))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// withIdempotencyKey returns headers extended with a generated
+// Idempotency-Key, leaving the caller's header map untouched. Requests
+// that already carry a key keep it.
+func (c *Client) withIdempotencyKey(method, endpoint string, payload []byte, headers http.Header) http.Header {
+	if c.idempotencyGen == nil || method == http.MethodGet {
+		return headers
+	}
+	if headers.Get(idempotencyKeyHeader) != "" {
+		return headers
+	}
+	key := c.idempotencyGen(method, endpoint, payload)
+	if key == "" {
+		return headers
+	}
+	merged := make(http.Header, len(headers)+1)
+	for name, values := range headers {
+		merged[name] = values
+	}
+	merged.Set(idempotencyKeyHeader, key)
+	return merged
+}
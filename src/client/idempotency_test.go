@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyKeyStableAcrossRetries(t *testing.T) {
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(1),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+		WithIdempotencyKeyGenerator(ContentHashIdempotencyGenerator))
+	if _, err := c.DoRequest(context.Background(), http.MethodPost, "/v2/pay", map[string]any{"amount": 1}); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("attempts = %d, want 2", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("keys = %v, want the same non-empty key on both attempts", keys)
+	}
+}
+
+func TestIdempotencyKeyVariesByRequest(t *testing.T) {
+	a := ContentHashIdempotencyGenerator(http.MethodPost, "/v2/pay", []byte(`{"amount":1}`))
+	b := ContentHashIdempotencyGenerator(http.MethodPost, "/v2/pay", []byte(`{"amount":2}`))
+	if a == b {
+		t.Error("different bodies produced the same idempotency key")
+	}
+	if c := ContentHashIdempotencyGenerator(http.MethodPost, "/v2/payout", []byte(`{"amount":1}`)); c == a {
+		t.Error("different endpoints produced the same idempotency key")
+	}
+}
+
+func TestIdempotencyKeySkipsGET(t *testing.T) {
+	var key string
+	var present bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get("Idempotency-Key")
+		_, present = r.Header["Idempotency-Key"]
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithIdempotencyKeyGenerator(ContentHashIdempotencyGenerator))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/status", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if present || key != "" {
+		t.Errorf("GET request carried Idempotency-Key %q", key)
+	}
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"net"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// VerifyCallbackIP checks a callback source address against the configured
+// whitelist. remoteAddr may be a bare IP or an "ip:port" pair as found in
+// http.Request.RemoteAddr. With an empty whitelist every address passes.
+func (c *Client) VerifyCallbackIP(remoteAddr string) error {
+	if len(c.CallbackIPWhitelist) == 0 {
+		return nil
+	}
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+	if c.IsIPWhitelisted(host) {
+		return nil
+	}
+	return c.Error(errors.ErrUnauthorizedIP, host)
+}
+
+// IsIPWhitelisted reports whether the bare IP is covered by the configured
+// whitelist entries. Unparseable input is never whitelisted.
+func (c *Client) IsIPWhitelisted(ipStr string) bool {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return false
+	}
+	for _, allowed := range c.parsedIPs {
+		if allowed.Equal(ip) {
+			return true
+		}
+	}
+	for _, ipNet := range c.parsedIPNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// DefaultAuthKeyValidator accepts non-empty keys made entirely of hex
+// characters, the format GSPAY2 issues. It does not check the length; use
+// StrictKeyValidator to require exactly 32 characters.
+func DefaultAuthKeyValidator(key string) error {
+	if key == "" {
+		return fmt.Errorf("key is empty")
+	}
+	for _, r := range key {
+		switch {
+		case r >= '0' && r <= '9', r >= 'a' && r <= 'f', r >= 'A' && r <= 'F':
+		default:
+			return fmt.Errorf("key contains non-hex character %q", r)
+		}
+	}
+	return nil
+}
+
+// StrictKeyValidator is DefaultAuthKeyValidator plus a length check:
+// GSPAY2 keys are 32 hex characters.
+func StrictKeyValidator(key string) error {
+	if err := DefaultAuthKeyValidator(key); err != nil {
+		return err
+	}
+	if len(key) != 32 {
+		return fmt.Errorf("key length is %d, want 32", len(key))
+	}
+	return nil
+}
+
+// WithAuthKeyValidator validates the auth key during New. A failure is
+// stored on the client and returned from every DoRequest as
+// errors.ErrInvalidConfiguration, before any network traffic.
+func WithAuthKeyValidator(fn func(string) error) Option {
+	return func(c *Client) { c.authKeyValidator = fn }
+}
+
+// WithSecretKeyValidator validates the secret key during New, with the
+// same failure behaviour as WithAuthKeyValidator.
+func WithSecretKeyValidator(fn func(string) error) Option {
+	return func(c *Client) { c.secretKeyValidator = fn }
+}
+
+// applyKeyValidators runs the configured key validators and records the
+// first failure as the client's configuration error.
+func (c *Client) applyKeyValidators() {
+	if c.authKeyValidator != nil {
+		if err := c.authKeyValidator(c.AuthKey); err != nil {
+			c.configErr = c.Error(errors.ErrInvalidConfiguration, fmt.Sprintf("auth key: %v", err))
+			return
+		}
+	}
+	if c.secretKeyValidator != nil {
+		if err := c.secretKeyValidator(c.SecretKey); err != nil {
+			c.configErr = c.Error(errors.ErrInvalidConfiguration, fmt.Sprintf("secret key: %v", err))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestDefaultAuthKeyValidator(t *testing.T) {
+	if err := DefaultAuthKeyValidator("deadbeef0123456789ABCDEF"); err != nil {
+		t.Errorf("hex key: %v, want nil", err)
+	}
+	if err := DefaultAuthKeyValidator(""); err == nil {
+		t.Error("empty key accepted, want error")
+	}
+	if err := DefaultAuthKeyValidator("not-hex!"); err == nil {
+		t.Error("non-hex key accepted, want error")
+	}
+}
+
+func TestStrictKeyValidator(t *testing.T) {
+	key := strings.Repeat("ab", 16)
+	if err := StrictKeyValidator(key); err != nil {
+		t.Errorf("32-char hex key: %v, want nil", err)
+	}
+	if err := StrictKeyValidator("abcdef"); err == nil {
+		t.Error("short key accepted, want error")
+	}
+}
+
+func TestKeyValidatorBlocksRequests(t *testing.T) {
+	called := false
+	c := New("", "secret",
+		WithBaseURL("http://127.0.0.1:1"),
+		WithAuthKeyValidator(DefaultAuthKeyValidator),
+		WithOnRequestComplete(func(string, string, int, time.Duration, error) {
+			called = true
+		}))
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if !errors.Is(err, errors.ErrInvalidConfiguration) {
+		t.Fatalf("error = %v, want ErrInvalidConfiguration", err)
+	}
+	if called {
+		t.Error("request reached the transport despite invalid configuration")
+	}
+}
+
+func TestSecretKeyValidatorBlocksRequests(t *testing.T) {
+	c := New(strings.Repeat("ab", 16), "tooshort",
+		WithAuthKeyValidator(StrictKeyValidator),
+		WithSecretKeyValidator(StrictKeyValidator))
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if !errors.Is(err, errors.ErrInvalidConfiguration) {
+		t.Fatalf("error = %v, want ErrInvalidConfiguration", err)
+	}
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// concurrencyLimiter caps how many requests execute at once. It lives
+// behind a pointer so Client stays copyable for Clone.
+type concurrencyLimiter struct {
+	sem     chan struct{}
+	pending atomic.Int64
+}
+
+// WithMaxConcurrentRequests caps the number of requests the client
+// executes at the same time; further requests wait for a slot. It keeps
+// fan-out helpers such as payout.IDRService.BatchCreate from launching an
+// unbounded number of simultaneous connections. n = 0 removes the cap,
+// which is the default.
+func WithMaxConcurrentRequests(n int) Option {
+	return func(c *Client) {
+		if n <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = &concurrencyLimiter{sem: make(chan struct{}, n)}
+	}
+}
+
+// PendingRequests returns the number of requests currently waiting for a
+// concurrency slot. It is always zero without WithMaxConcurrentRequests.
+func (c *Client) PendingRequests() int {
+	if c.limiter == nil {
+		return 0
+	}
+	return int(c.limiter.pending.Load())
+}
+
+// acquireSlot blocks until a concurrency slot is free, returning a
+// release function, or gives up with ctx.Err() when ctx is cancelled
+// while waiting. Without WithMaxConcurrentRequests it is free of
+// synchronization.
+func (c *Client) acquireSlot(ctx context.Context) (func(), error) {
+	if c.limiter == nil {
+		return func() {}, nil
+	}
+	c.limiter.pending.Add(1)
+	defer c.limiter.pending.Add(-1)
+	select {
+	case c.limiter.sem <- struct{}{}:
+		return func() { <-c.limiter.sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newCountingServer(t *testing.T, release chan struct{}, active, peak *atomic.Int64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		<-release
+		active.Add(-1)
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestMaxConcurrentRequestsLimitsConcurrency(t *testing.T) {
+	const limit = 2
+	var active, peak atomic.Int64
+	release := make(chan struct{})
+	srv := newCountingServer(t, release, &active, &peak)
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithMaxConcurrentRequests(limit))
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{}
+			if err := c.Get(context.Background(), "/probe", &out); err != nil {
+				t.Errorf("Get: %v", err)
+			}
+		}()
+	}
+	for c.PendingRequests() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if got := peak.Load(); got != limit {
+		t.Errorf("peak concurrency = %d, want %d", got, limit)
+	}
+	if got := c.PendingRequests(); got != 0 {
+		t.Errorf("PendingRequests after completion = %d, want 0", got)
+	}
+}
+
+func TestMaxConcurrentRequestsCancelledWhileQueued(t *testing.T) {
+	var active, peak atomic.Int64
+	release := make(chan struct{})
+	srv := newCountingServer(t, release, &active, &peak)
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithMaxConcurrentRequests(1))
+	go func() {
+		var out struct{}
+		c.Get(context.Background(), "/probe", &out)
+	}()
+	for active.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var out struct{}
+	err := c.Get(ctx, "/probe", &out)
+	if err != context.DeadlineExceeded {
+		t.Errorf("queued Get error = %v, want context.DeadlineExceeded", err)
+	}
+	close(release)
+}
+
+func TestMaxConcurrentRequestsPendingCount(t *testing.T) {
+	var active, peak atomic.Int64
+	release := make(chan struct{})
+	srv := newCountingServer(t, release, &active, &peak)
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithMaxConcurrentRequests(1))
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{}
+			c.Get(context.Background(), "/probe", &out)
+		}()
+	}
+	deadline := time.Now().Add(time.Second)
+	for c.PendingRequests() != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := c.PendingRequests(); got != 2 {
+		t.Errorf("PendingRequests = %d, want 2", got)
+	}
+	close(release)
+	wg.Wait()
+}
+
+func TestMaxConcurrentRequestsZeroIsUnlimited(t *testing.T) {
+	const workers = 5
+	var active, peak atomic.Int64
+	release := make(chan struct{})
+	srv := newCountingServer(t, release, &active, &peak)
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithMaxConcurrentRequests(0))
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{}
+			if err := c.Get(context.Background(), "/probe", &out); err != nil {
+				t.Errorf("Get: %v", err)
+			}
+		}()
+	}
+	for active.Load() != workers {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if got := peak.Load(); got != workers {
+		t.Errorf("peak concurrency = %d, want %d", got, workers)
+	}
+}
+
+func TestMaxConcurrentRequestsWithDrain(t *testing.T) {
+	var active, peak atomic.Int64
+	release := make(chan struct{})
+	srv := newCountingServer(t, release, &active, &peak)
+
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithMaxConcurrentRequests(1), WithGracefulShutdown())
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{}
+			c.Get(context.Background(), "/probe", &out)
+		}()
+	}
+	for active.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := c.Drain(ctx); err != nil {
+		t.Fatalf("Drain with a concurrency limit: %v", err)
+	}
+	wg.Wait()
+	if got := c.InFlightCount(); got != 0 {
+		t.Errorf("InFlightCount after drain = %d, want 0", got)
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultMetricsNamespace prefixes the metric names registered by
+// WithMetrics.
+const DefaultMetricsNamespace = "gspay"
+
+// clientMetrics holds the Prometheus collectors registered by WithMetrics.
+type clientMetrics struct {
+	requests  *prometheus.CounterVec
+	duration  *prometheus.HistogramVec
+	retries   *prometheus.CounterVec
+	rateLimit *prometheus.CounterVec
+}
+
+// WithMetrics registers request metrics with the given registerer:
+// requests_total, request_duration_seconds, retries_total and
+// rate_limit_total, all under the configured namespace.
+func WithMetrics(reg prometheus.Registerer) Option {
+	return func(c *Client) { c.metricsReg = reg }
+}
+
+// WithMetricsNamespace overrides the namespace the WithMetrics collectors
+// are registered under. The default is DefaultMetricsNamespace.
+func WithMetricsNamespace(ns string) Option {
+	return func(c *Client) {
+		if ns != "" {
+			c.metricsNamespace = ns
+		}
+	}
+}
+
+// applyMetrics builds and registers the collectors after all options have
+// been applied, so WithMetrics and WithMetricsNamespace compose in any
+// order.
+func (c *Client) applyMetrics() {
+	if c.metricsReg == nil {
+		return
+	}
+	ns := c.metricsNamespace
+	if ns == "" {
+		ns = DefaultMetricsNamespace
+	}
+	c.metrics = &clientMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "requests_total",
+			Help:      "API requests executed, by method, endpoint type and status.",
+		}, []string{"method", "endpoint_type", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "request_duration_seconds",
+			Help:      "API request duration including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "endpoint_type"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "retries_total",
+			Help:      "Retry attempts performed, by endpoint type.",
+		}, []string{"endpoint_type"}),
+		rateLimit: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "rate_limit_total",
+			Help:      "Responses rejected by GSPAY2 rate limiting.",
+		}, []string{"endpoint_type"}),
+	}
+	c.metricsReg.MustRegister(c.metrics.requests, c.metrics.duration, c.metrics.retries, c.metrics.rateLimit)
+}
+
+// endpointType maps an endpoint path to a low-cardinality metric label.
+func endpointType(endpoint string) string {
+	switch {
+	case strings.Contains(endpoint, "/idr/payout"):
+		return "idr_payout"
+	case strings.Contains(endpoint, "/idr/payment"):
+		return "idr_payment"
+	case strings.Contains(endpoint, "/myr/payout"):
+		return "myr_payout"
+	case strings.Contains(endpoint, "/myr/payment"):
+		return "myr_payment"
+	case strings.Contains(endpoint, "/cryptocurrency/"):
+		return "usdt_payment"
+	case strings.Contains(endpoint, "/balance"):
+		return "balance"
+	}
+	return "other"
+}
+
+// observeRequest records one executed request. status is the HTTP status
+// code, or "error" when the request never produced a response.
+func (c *Client) observeRequest(method, endpoint string, statusCode int, attempts int, elapsed time.Duration) {
+	if c.metrics == nil {
+		return
+	}
+	et := endpointType(endpoint)
+	status := "error"
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	c.metrics.requests.WithLabelValues(method, et, status).Inc()
+	c.metrics.duration.WithLabelValues(method, et).Observe(elapsed.Seconds())
+	if attempts > 0 {
+		c.metrics.retries.WithLabelValues(et).Add(float64(attempts))
+	}
+	if statusCode == 429 {
+		c.metrics.rateLimit.WithLabelValues(et).Inc()
+	}
+}
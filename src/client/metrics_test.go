@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestWithMetricsCountsRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	reg := prometheus.NewRegistry()
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithMetrics(reg))
+	endpoint := "/v2/integrations/operators/auth/idr/payment"
+	if _, err := c.DoRequest(context.Background(), http.MethodPost, endpoint, map[string]any{}); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+
+	want := `
+		# HELP gspay_requests_total API requests executed, by method, endpoint type and status.
+		# TYPE gspay_requests_total counter
+		gspay_requests_total{endpoint_type="idr_payment",method="POST",status="200"} 1
+	`
+	if err := testutil.CollectAndCompare(c.metrics.requests, strings.NewReader(want)); err != nil {
+		t.Errorf("requests_total mismatch: %v", err)
+	}
+}
+
+func TestWithMetricsCountsRetries(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	reg := prometheus.NewRegistry()
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(1),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+		WithMetrics(reg))
+	endpoint := "/v2/integrations/operators/auth/balance"
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, endpoint, nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+
+	want := `
+		# HELP gspay_retries_total Retry attempts performed, by endpoint type.
+		# TYPE gspay_retries_total counter
+		gspay_retries_total{endpoint_type="balance"} 1
+	`
+	if err := testutil.CollectAndCompare(c.metrics.retries, strings.NewReader(want)); err != nil {
+		t.Errorf("retries_total mismatch: %v", err)
+	}
+}
+
+func TestWithMetricsNamespace(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := New("auth", "secret",
+		WithMetricsNamespace("myapp"),
+		WithMetrics(reg),
+		WithMockTransport(NewMockTransport(MockResponse{Body: map[string]any{}})))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	count, err := testutil.GatherAndCount(reg, "myapp_requests_total")
+	if err != nil {
+		t.Fatalf("GatherAndCount: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("myapp_requests_total series = %d, want 1", count)
+	}
+}
+
+func TestEndpointType(t *testing.T) {
+	cases := map[string]string{
+		"/v2/integrations/operators/k/idr/payment":               "idr_payment",
+		"/v2/integrations/operators/k/idr/payout/status":         "idr_payout",
+		"/v2/integrations/operators/k/myr/payment":               "myr_payment",
+		"/v2/integrations/operators/k/myr/payout":                "myr_payout",
+		"/v2/integrations/operators/k/cryptocurrency/trc20/usdt": "usdt_payment",
+		"/v2/integrations/operators/k/balance":                   "balance",
+		"/v2/ping":                                               "other",
+	}
+	for endpoint, want := range cases {
+		if got := endpointType(endpoint); got != want {
+			t.Errorf("endpointType(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTPMiddleware wraps an http.RoundTripper with additional behaviour such
+// as extra headers, response decryption or wire-level logging.
+type HTTPMiddleware func(http.RoundTripper) http.RoundTripper
+
+// WithHTTPTransportMiddleware wraps the client transport with the given
+// middlewares. The first middleware is outermost: it sees the request
+// first and the response last. When a custom HTTP client is supplied via
+// WithHTTPClient its transport is wrapped in place.
+func WithHTTPTransportMiddleware(mw ...HTTPMiddleware) Option {
+	return func(c *Client) { c.middlewares = append(c.middlewares, mw...) }
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// applyMiddleware wraps the HTTP client's transport with the configured
+// middlewares. It runs after the transport has been built so the chain
+// applies regardless of option order.
+func (c *Client) applyMiddleware() {
+	if len(c.middlewares) == 0 {
+		return
+	}
+	rt := c.HTTPClient.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+	c.HTTPClient.Transport = rt
+}
+
+// LoggingMiddleware logs every request and response at the HTTP level:
+// method, URL, headers and status. It is meant for debugging the raw wire
+// format; the messages are not localized or sanitized.
+func LoggingMiddleware(h logger.Handler) HTTPMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			h.Debug("http request",
+				"method", req.Method,
+				"url", req.URL.String(),
+				"headers", headerPairs(req.Header))
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				h.Debug("http error", "method", req.Method, "url", req.URL.String(), "error", err.Error())
+				return nil, err
+			}
+			h.Debug("http response",
+				"status", resp.StatusCode,
+				"url", req.URL.String(),
+				"headers", headerPairs(resp.Header))
+			return resp, nil
+		})
+	}
+}
+
+// headerPairs flattens headers into a compact "Key=v1" list for logging.
+func headerPairs(h http.Header) []string {
+	pairs := make([]string, 0, len(h))
+	for k, vs := range h {
+		for _, v := range vs {
+			pairs = append(pairs, k+"="+v)
+		}
+	}
+	return pairs
+}
+
+// MetricsMiddleware counts requests and their durations at the HTTP level,
+// before the SDK's retry and parsing layers. Unlike WithMetrics it sees
+// each retry attempt as a separate request.
+func MetricsMiddleware(reg prometheus.Registerer) HTTPMiddleware {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: DefaultMetricsNamespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP round trips executed, by method and status.",
+	}, []string{"method", "status"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: DefaultMetricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP round trip duration.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	reg.MustRegister(requests, duration)
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			duration.WithLabelValues(req.Method).Observe(time.Since(start).Seconds())
+			status := "error"
+			if err == nil {
+				status = strconv.Itoa(resp.StatusCode)
+			}
+			requests.WithLabelValues(req.Method, status).Inc()
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func appendMiddleware(order *[]string, name string) HTTPMiddleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			*order = append(*order, name)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func TestMiddlewareChainingOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	var order []string
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithHTTPTransportMiddleware(
+			appendMiddleware(&order, "first"),
+			appendMiddleware(&order, "second"),
+		),
+		WithHTTPTransportMiddleware(appendMiddleware(&order, "third")),
+	)
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	want := []string{"first", "second", "third"}
+	if len(order) != len(want) {
+		t.Fatalf("middleware calls = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("middleware calls = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestMiddlewareWrapsCustomHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	var called bool
+	custom := &http.Client{}
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithHTTPClient(custom),
+		WithHTTPTransportMiddleware(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				called = true
+				return next.RoundTrip(req)
+			})
+		}),
+	)
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !called {
+		t.Error("middleware not invoked on custom HTTP client")
+	}
+}
+
+func TestLoggingMiddlewareCapturesHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Probe", "pong")
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	ml := &mockLogger{}
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithHTTPTransportMiddleware(LoggingMiddleware(ml)),
+	)
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	var reqLogged, respLogged bool
+	for _, e := range ml.entries {
+		if e.msg == "http request" {
+			reqLogged = true
+		}
+		if e.msg == "http response" {
+			respLogged = true
+			joined := ""
+			for _, a := range e.kv {
+				if ss, ok := a.([]string); ok {
+					joined = strings.Join(ss, " ")
+				}
+			}
+			if !strings.Contains(joined, "X-Probe=pong") {
+				t.Errorf("response log args = %v, want X-Probe header captured", e.kv)
+			}
+		}
+	}
+	if !reqLogged || !respLogged {
+		t.Errorf("logged request=%v response=%v, want both", reqLogged, respLogged)
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/internal/sanitize"
+)
+
+// MockResponse describes one canned response replayed by MockTransport.
+type MockResponse struct {
+	// StatusCode is the HTTP status to return; zero means 200.
+	StatusCode int
+	// Body is marshalled to JSON and returned as the response body.
+	Body any
+	// Delay is slept before the response is returned, simulating latency.
+	Delay time.Duration
+	// Err, when non-nil, is returned instead of a response, simulating a
+	// network-level failure.
+	Err error
+}
+
+// RecordedCall captures one request seen by MockTransport.
+type RecordedCall struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the full request URL with the auth key redacted.
+	URL string
+	// Headers is a copy of the request headers.
+	Headers http.Header
+	// Body is the request body decoded from JSON, or nil for empty bodies.
+	Body any
+}
+
+// MockTransport is an http.RoundTripper that replays pre-configured
+// responses in order and records every request it sees. It is safe for
+// concurrent use. It panics when a request arrives after all responses
+// have been consumed, which surfaces miscounted expectations immediately.
+type MockTransport struct {
+	mu        sync.Mutex
+	responses []MockResponse
+	next      int
+	calls     []RecordedCall
+}
+
+// NewMockTransport returns a MockTransport replaying the given responses.
+func NewMockTransport(responses ...MockResponse) *MockTransport {
+	return &MockTransport{responses: responses}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *MockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := RecordedCall{
+		Method:  req.Method,
+		URL:     sanitize.Endpoint(req.URL.String()),
+		Headers: req.Header.Clone(),
+	}
+	if req.Body != nil {
+		payload, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(payload) > 0 {
+			var decoded any
+			if err := json.Unmarshal(payload, &decoded); err == nil {
+				call.Body = decoded
+			} else {
+				call.Body = string(payload)
+			}
+		}
+	}
+	t.mu.Lock()
+	if t.next >= len(t.responses) {
+		t.mu.Unlock()
+		panic(fmt.Sprintf("gspay: MockTransport: no response configured for request %d (%s %s)",
+			t.next+1, call.Method, call.URL))
+	}
+	resp := t.responses[t.next]
+	t.next++
+	t.calls = append(t.calls, call)
+	t.mu.Unlock()
+
+	if resp.Delay > 0 {
+		timer := time.NewTimer(resp.Delay)
+		defer timer.Stop()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	status := resp.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	body, err := json.Marshal(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: status,
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// Calls returns a copy of the requests recorded so far.
+func (t *MockTransport) Calls() []RecordedCall {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	calls := make([]RecordedCall, len(t.calls))
+	copy(calls, t.calls)
+	return calls
+}
+
+// WithMockTransport backs the client's HTTP client with the given mock.
+func WithMockTransport(t *MockTransport) Option {
+	return func(c *Client) {
+		c.HTTPClient = &http.Client{Transport: t, Timeout: DefaultTimeout}
+		c.customHTTPClient = true
+	}
+}
+
+// NewMock returns a Client that replays the given responses instead of
+// contacting GSPAY2, with retries disabled. Keep a reference to the
+// transport via NewMockTransport and WithMockTransport when the test needs
+// to assert on recorded calls; the transport is also reachable through
+// HTTPClient.Transport.
+func NewMock(responses ...MockResponse) *Client {
+	return New("mock-auth-key", "mock-secret-key",
+		WithMockTransport(NewMockTransport(responses...)),
+		WithRetries(0))
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func mockEnvelope(data any) map[string]any {
+	return map[string]any{"code": 200, "message": "OK", "data": data}
+}
+
+func TestMockTransportReplaysInOrder(t *testing.T) {
+	mt := NewMockTransport(
+		MockResponse{Body: mockEnvelope(map[string]any{"n": 1})},
+		MockResponse{Body: mockEnvelope(map[string]any{"n": 2})},
+	)
+	c := New("auth-key", "secret-key", WithMockTransport(mt), WithRetries(0))
+
+	for want := 1; want <= 2; want++ {
+		var out struct {
+			N int `json:"n"`
+		}
+		if err := c.Get(context.Background(), "/v2/ping", &out); err != nil {
+			t.Fatalf("Get #%d: %v", want, err)
+		}
+		if out.N != want {
+			t.Errorf("response #%d: n = %d, want %d", want, out.N, want)
+		}
+	}
+}
+
+func TestMockTransportRecordsCalls(t *testing.T) {
+	mt := NewMockTransport(MockResponse{Body: mockEnvelope(map[string]any{})})
+	c := New("auth-key", "secret-key", WithMockTransport(mt), WithRetries(0))
+
+	endpoint := "/v2/integrations/operators/auth-key/idr/payment"
+	if err := c.Post(context.Background(), endpoint, map[string]any{"amount": 50000}, nil); err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	calls := mt.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("recorded %d calls, want 1", len(calls))
+	}
+	call := calls[0]
+	if call.Method != http.MethodPost {
+		t.Errorf("Method = %s, want POST", call.Method)
+	}
+	if call.URL != DefaultBaseURL+"/v2/integrations/operators/****/idr/payment" {
+		t.Errorf("URL = %q, want sanitized auth key", call.URL)
+	}
+	if call.Headers.Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", call.Headers.Get("Content-Type"))
+	}
+	body, ok := call.Body.(map[string]any)
+	if !ok || body["amount"] != float64(50000) {
+		t.Errorf("Body = %#v, want decoded amount 50000", call.Body)
+	}
+}
+
+func TestMockTransportNetworkError(t *testing.T) {
+	c := NewMock(MockResponse{Err: context.DeadlineExceeded})
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if !errors.Is(err, errors.ErrTimeout) {
+		t.Errorf("error = %v, want ErrTimeout", err)
+	}
+}
+
+func TestMockTransportPanicsWhenExhausted(t *testing.T) {
+	c := NewMock()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic when responses are exhausted")
+		}
+	}()
+	c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+}
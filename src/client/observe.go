@@ -0,0 +1,28 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// WithOnRetry registers a function called at the start of each retry, before
+// the backoff wait. It is not called for the initial attempt. resp is the
+// response that triggered the retry and is nil when the previous attempt
+// failed with a network error; its body has already been closed. The hook
+// runs synchronously on the request goroutine and is called even when the
+// request context has been cancelled.
+func WithOnRetry(fn func(attempt int, resp *http.Response, err error)) Option {
+	return func(c *Client) {
+		c.onRetry = fn
+	}
+}
+
+// WithOnRequestComplete registers a function called once per logical API
+// call, after the last attempt finishes — on success, on final failure and
+// on context cancellation alike. statusCode is zero when no HTTP response
+// was received. The hook runs synchronously on the request goroutine.
+func WithOnRequestComplete(fn func(method, endpoint string, statusCode int, duration time.Duration, err error)) Option {
+	return func(c *Client) {
+		c.onComplete = fn
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithOnRetryHTTPFailures(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	var attempts []int
+	var statuses []int
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(3),
+		WithRetryWait(time.Millisecond, 2*time.Millisecond),
+		WithOnRetry(func(attempt int, resp *http.Response, err error) {
+			attempts = append(attempts, attempt)
+			if resp == nil {
+				t.Errorf("attempt %d: resp is nil, want 500 response", attempt)
+				return
+			}
+			statuses = append(statuses, resp.StatusCode)
+		}))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if len(attempts) != 3 || attempts[0] != 1 || attempts[1] != 2 || attempts[2] != 3 {
+		t.Errorf("retry attempts = %v, want [1 2 3]", attempts)
+	}
+	for _, s := range statuses {
+		if s != http.StatusInternalServerError {
+			t.Errorf("retry status = %d, want 500", s)
+		}
+	}
+}
+
+func TestWithOnRetryNetworkError(t *testing.T) {
+	var calls int
+	c := New("auth", "secret",
+		WithBaseURL("http://127.0.0.1:1"),
+		WithRetries(1),
+		WithRetryWait(time.Millisecond, 2*time.Millisecond),
+		WithOnRetry(func(attempt int, resp *http.Response, err error) {
+			calls++
+			if resp != nil {
+				t.Errorf("resp = %v, want nil on network error", resp)
+			}
+			if err == nil {
+				t.Error("err is nil, want the network error")
+			}
+		}))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err == nil {
+		t.Fatal("DoRequest succeeded, want network error")
+	}
+	if calls != 1 {
+		t.Errorf("onRetry calls = %d, want 1", calls)
+	}
+}
+
+func TestWithOnRequestComplete(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	var calls int
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithOnRequestComplete(func(method, endpoint string, statusCode int, duration time.Duration, err error) {
+			calls++
+			if method != http.MethodGet || endpoint != "/v2/ping" {
+				t.Errorf("got %s %s, want GET /v2/ping", method, endpoint)
+			}
+			if statusCode != http.StatusOK {
+				t.Errorf("statusCode = %d, want 200", statusCode)
+			}
+			if err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+		}))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("onComplete calls = %d, want 1", calls)
+	}
+}
+
+func TestWithOnRequestCompleteCancelledContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var calls int
+	var gotErr error
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(3),
+		WithRetryWait(time.Second, time.Second),
+		WithOnRequestComplete(func(method, endpoint string, statusCode int, duration time.Duration, err error) {
+			calls++
+			gotErr = err
+		}))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.DoRequest(ctx, http.MethodGet, "/v2/ping", nil); err == nil {
+		t.Fatal("DoRequest succeeded, want context error")
+	}
+	if calls != 1 {
+		t.Errorf("onComplete calls = %d, want 1", calls)
+	}
+	if gotErr == nil {
+		t.Error("onComplete err is nil, want context cancellation error")
+	}
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+	"github.com/nikon1313/gspay-go-sdk/src/signature"
+)
+
+// Option configures a Client during New.
+type Option func(*Client)
+
+// WithBaseURL overrides the API origin, e.g. for a sandbox environment.
+// A trailing slash is stripped.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.BaseURL = baseURL }
+}
+
+// WithHTTPClient supplies a custom *http.Client. The client is used as-is;
+// its Timeout takes precedence over WithTimeout.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = hc
+		c.customHTTPClient = true
+	}
+}
+
+// WithTimeout sets the overall per-request timeout. Ignored when a custom
+// HTTP client is supplied via WithHTTPClient.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		if c.HTTPClient == nil {
+			c.HTTPClient = &http.Client{}
+		}
+		c.HTTPClient.Timeout = d
+	}
+}
+
+// WithRetries sets the number of retry attempts after the initial request.
+// Zero disables retries.
+func WithRetries(n int) Option {
+	return func(c *Client) { c.Retries = n }
+}
+
+// WithMaxRetries is an alias for WithRetries, for callers that prefer the
+// explicit name.
+func WithMaxRetries(n int) Option {
+	return WithRetries(n)
+}
+
+// WithNoRetry disables retries entirely. Use it when request idempotency
+// cannot be guaranteed.
+func WithNoRetry() Option {
+	return WithRetries(0)
+}
+
+// WithRetryWait bounds the exponential backoff between retry attempts.
+func WithRetryWait(min, max time.Duration) Option {
+	return func(c *Client) {
+		c.RetryWaitMin = min
+		c.RetryWaitMax = max
+	}
+}
+
+// WithMinRetryWait sets only the lower backoff bound.
+func WithMinRetryWait(d time.Duration) Option {
+	return func(c *Client) { c.RetryWaitMin = d }
+}
+
+// WithMaxRetryWait sets only the upper backoff bound.
+func WithMaxRetryWait(d time.Duration) Option {
+	return func(c *Client) { c.RetryWaitMax = d }
+}
+
+// WithJitterFactor sets the share of each backoff interval that is
+// randomized, replacing the default of 0.25. Values are clamped to
+// [0.0, 1.0]; zero disables jitter.
+func WithJitterFactor(f float64) Option {
+	return func(c *Client) {
+		if f < 0 {
+			f = 0
+		}
+		if f > 1 {
+			f = 1
+		}
+		c.JitterFactor = f
+	}
+}
+
+// WithLanguage selects the language used for error messages. Invalid
+// languages are ignored and English remains active.
+func WithLanguage(lang i18n.Language) Option {
+	return func(c *Client) {
+		if lang.IsValid() {
+			c.Language = lang
+		}
+	}
+}
+
+// WithBulkTimeout bounds the total duration of a bulk operation such as
+// payment.IDRService.CreateBulk. Zero, the default, applies no bound
+// beyond the per-request timeouts.
+func WithBulkTimeout(d time.Duration) Option {
+	return func(c *Client) { c.bulkTimeout = d }
+}
+
+// WithDebug enables debug mode: log sanitization is disabled so full
+// request data, including account numbers, appears in log output. Never
+// enable in production.
+func WithDebug(debug bool) Option {
+	return func(c *Client) { c.Debug = debug }
+}
+
+// WithLogger supplies the log handler that receives SDK log messages. It
+// is mutually exclusive with WithStructuredLogger; whichever option comes
+// last wins and the replacement is logged as a warning.
+func WithLogger(h logger.Handler) Option {
+	return func(c *Client) {
+		if h != nil {
+			if c.structuredLogger {
+				h.Warn(c.I18n(i18n.LogLoggerOverride))
+				c.structuredLogger = false
+			}
+			c.logger = h
+			c.customLogger = true
+		}
+	}
+}
+
+// WithStructuredLogger routes SDK log output to a logger.StructuredHandler:
+// the key/value pairs of each log call are collected into an attribute
+// map. It is mutually exclusive with WithLogger; whichever option comes
+// last wins and the replacement is logged as a warning.
+func WithStructuredLogger(h logger.StructuredHandler) Option {
+	return func(c *Client) {
+		if h == nil {
+			return
+		}
+		adapted := logger.FromStructured(h)
+		if c.customLogger && !c.structuredLogger {
+			adapted.Warn(c.I18n(i18n.LogLoggerOverride))
+		}
+		c.logger = adapted
+		c.customLogger = true
+		c.structuredLogger = true
+	}
+}
+
+// WithSlogHandler routes SDK log output to a log/slog handler. It is a
+// convenience wrapper around WithLogger(logger.NewSlog(h)).
+func WithSlogHandler(h slog.Handler) Option {
+	return func(c *Client) {
+		if h != nil {
+			c.logger = logger.NewSlog(h)
+			c.customLogger = true
+		}
+	}
+}
+
+// WithDigest replaces the MD5 digest used for request signing and
+// callback verification, for GSPAY2 configurations that support stronger
+// schemes such as SHA-256 or HMAC.
+func WithDigest(d signature.Digest) Option {
+	return func(c *Client) { c.digest = d }
+}
+
+// WithCallbackIPWhitelist restricts callback verification to the given IPs
+// and CIDR ranges. Invalid entries are skipped.
+func WithCallbackIPWhitelist(entries []string) Option {
+	return func(c *Client) {
+		c.CallbackIPWhitelist = entries
+		c.parsedIPs = nil
+		c.parsedIPNets = nil
+		for _, e := range entries {
+			if _, ipNet, err := net.ParseCIDR(e); err == nil {
+				c.parsedIPNets = append(c.parsedIPNets, ipNet)
+				continue
+			}
+			if ip := net.ParseIP(e); ip != nil {
+				c.parsedIPs = append(c.parsedIPs, ip)
+			}
+		}
+	}
+}
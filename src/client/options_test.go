@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestRetryWaitStandaloneOptions(t *testing.T) {
+	c := New("auth", "secret",
+		WithMaxRetries(5),
+		WithMinRetryWait(100*time.Millisecond),
+		WithMaxRetryWait(2*time.Second))
+	if c.Retries != 5 {
+		t.Errorf("Retries = %d, want 5", c.Retries)
+	}
+	if c.RetryWaitMin != 100*time.Millisecond {
+		t.Errorf("RetryWaitMin = %v, want 100ms", c.RetryWaitMin)
+	}
+	if c.RetryWaitMax != 2*time.Second {
+		t.Errorf("RetryWaitMax = %v, want 2s", c.RetryWaitMax)
+	}
+}
+
+func TestWithNoRetry(t *testing.T) {
+	c := New("auth", "secret", WithNoRetry())
+	if c.Retries != 0 {
+		t.Errorf("Retries = %d, want 0", c.Retries)
+	}
+}
+
+func TestWithJitterFactorClamped(t *testing.T) {
+	if c := New("auth", "secret", WithJitterFactor(-0.5)); c.JitterFactor != 0 {
+		t.Errorf("JitterFactor = %v, want 0 for negative input", c.JitterFactor)
+	}
+	if c := New("auth", "secret", WithJitterFactor(2)); c.JitterFactor != 1 {
+		t.Errorf("JitterFactor = %v, want 1 for input above one", c.JitterFactor)
+	}
+	if c := New("auth", "secret"); c.JitterFactor != DefaultJitterFactor {
+		t.Errorf("JitterFactor = %v, want default %v", c.JitterFactor, DefaultJitterFactor)
+	}
+}
+
+func TestWaitBackoffJitterRange(t *testing.T) {
+	c := New("auth", "secret",
+		WithRetryWait(time.Second, time.Second),
+		WithJitterFactor(0.5))
+	min := 500 * time.Millisecond
+	max := 1500 * time.Millisecond
+	for i := 0; i < 100; i++ {
+		wait := c.waitBackoff(1)
+		if wait < min || wait > max {
+			t.Fatalf("waitBackoff = %v, want within [%v, %v]", wait, min, max)
+		}
+	}
+}
+
+func TestWaitBackoffNoJitter(t *testing.T) {
+	c := New("auth", "secret",
+		WithRetryWait(time.Second, time.Second),
+		WithJitterFactor(0))
+	for i := 0; i < 10; i++ {
+		if wait := c.waitBackoff(1); wait != time.Second {
+			t.Fatalf("waitBackoff = %v, want exactly 1s with zero jitter", wait)
+		}
+	}
+}
+
+func TestWithBaseURLValidatorStrict(t *testing.T) {
+	cases := []struct {
+		name    string
+		baseURL string
+		wantErr bool
+	}{
+		{"clean https", "https://api.example.com", false},
+		{"trailing slash", "https://api.example.com/", false},
+		{"extra path", "https://api.example.com/v2", true},
+		{"query string", "https://api.example.com?env=test", true},
+		{"bad scheme", "ftp://api.example.com", true},
+		{"no host", "https://", true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New("auth", "secret", WithBaseURL(tt.baseURL), WithBaseURLValidator(true))
+			var out struct{}
+			err := c.Get(context.Background(), "/probe", &out)
+			if tt.wantErr {
+				if !errors.Is(err, errors.ErrInvalidConfiguration) {
+					t.Errorf("Get error = %v, want ErrInvalidConfiguration", err)
+				}
+				return
+			}
+			if errors.Is(err, errors.ErrInvalidConfiguration) {
+				t.Errorf("Get error = %v, want no configuration error", err)
+			}
+		})
+	}
+}
+
+func TestNonStrictBaseURLWarnsOnPath(t *testing.T) {
+	ml := &mockLogger{}
+	New("auth", "secret", WithLogger(ml), WithBaseURL("https://api.example.com/v2"))
+	var warned bool
+	for _, e := range ml.entries {
+		if e.level == "warn" {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Error("no warning logged for base URL with path")
+	}
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// PingTimeout caps how long Ping waits for a response, regardless of the
+// client's configured timeout.
+const PingTimeout = 5 * time.Second
+
+// Ping verifies that the GSPAY2 API is reachable and the operator
+// credentials are accepted, using a lightweight authenticated balance
+// request. It returns nil on success, errors.ErrUnauthenticated when the
+// credentials are rejected, and the usual transport errors otherwise.
+func (c *Client) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, PingTimeout)
+	defer cancel()
+	_, err := c.DoRequest(ctx, http.MethodGet, c.Endpoint(constants.EndpointBalance), nil)
+	if err == nil {
+		return nil
+	}
+	var apiErr *errors.APIError
+	if errors.As(err, &apiErr) && (apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden) {
+		return c.Error(errors.ErrUnauthenticated, fmt.Sprintf("status %d", apiErr.Code))
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestPingSucceeds(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{"balance":1}}`))
+	}))
+	defer srv.Close()
+	c := New("auth", "secret", WithBaseURL(srv.URL))
+	if err := c.Ping(context.Background()); err != nil {
+		t.Errorf("Ping: %v", err)
+	}
+}
+
+func TestPingUnauthenticated(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"code":401,"message":"unauthorized"}`))
+		}))
+		c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0))
+		if err := c.Ping(context.Background()); !errors.Is(err, errors.ErrUnauthenticated) {
+			t.Errorf("status %d: Ping error = %v, want ErrUnauthenticated", status, err)
+		}
+		srv.Close()
+	}
+}
+
+func TestPingRespectsTimeoutCap(t *testing.T) {
+	if testing.Short() {
+		t.Skip("slow server test")
+	}
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0), WithTimeout(time.Hour))
+	start := time.Now()
+	err := c.Ping(context.Background())
+	if err == nil {
+		t.Fatal("Ping: want timeout error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > PingTimeout+2*time.Second {
+		t.Errorf("Ping took %v, want under %v", elapsed, PingTimeout+2*time.Second)
+	}
+}
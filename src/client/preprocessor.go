@@ -0,0 +1,47 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+)
+
+// RequestPreprocessor mutates an outgoing HTTP request after the standard
+// headers are set, for setups that need extra per-request signing such as
+// an API gateway in front of GSPAY2.
+type RequestPreprocessor func(req *http.Request) error
+
+// WithRequestPreprocessor runs fn on every outgoing request, including each
+// retry attempt, after the standard headers are set. A preprocessor error
+// aborts the request without retrying, since it signals a configuration
+// problem rather than a transient failure.
+func WithRequestPreprocessor(fn RequestPreprocessor) Option {
+	return func(c *Client) { c.preprocessor = fn }
+}
+
+// HMACPreprocessor returns a preprocessor that computes
+// HMAC-SHA256(secret, method+url+body) over the request and sets the hex
+// digest as the named header, for gateways that authenticate requests
+// independently of the GSPAY2 keys.
+func HMACPreprocessor(secret, headerName string) RequestPreprocessor {
+	return func(req *http.Request) error {
+		mac := hmac.New(sha256.New, []byte(secret))
+		io.WriteString(mac, req.Method)
+		io.WriteString(mac, req.URL.String())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(mac, body)
+			body.Close()
+			if err != nil {
+				return err
+			}
+		}
+		req.Header.Set(headerName, hex.EncodeToString(mac.Sum(nil)))
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestPreprocessorCalledOnEachAttempt(t *testing.T) {
+	var attempts atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	var calls atomic.Int64
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithRetries(2), WithRetryWait(time.Millisecond, time.Millisecond),
+		WithRequestPreprocessor(func(req *http.Request) error {
+			calls.Add(1)
+			return nil
+		}))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("preprocessor called %d times, want once per attempt (3)", got)
+	}
+}
+
+func TestRequestPreprocessorErrorAbortsWithoutRetry(t *testing.T) {
+	var hits atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithRetries(3), WithRetryWait(time.Millisecond, time.Millisecond),
+		WithRequestPreprocessor(func(*http.Request) error {
+			return fmt.Errorf("gateway key not configured")
+		}))
+	var out struct{}
+	err := c.Get(context.Background(), "/probe", &out)
+	if err == nil || err.Error() != "gateway key not configured" {
+		t.Fatalf("Get error = %v, want the preprocessor error", err)
+	}
+	if hits.Load() != 0 {
+		t.Errorf("server received %d requests, want none", hits.Load())
+	}
+}
+
+func TestHMACPreprocessorSignatureDependsOnBody(t *testing.T) {
+	pp := HMACPreprocessor("gw-secret", "X-Gateway-Signature")
+	sign := func(body string) string {
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/v1/probe", bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := pp(req); err != nil {
+			t.Fatalf("preprocessor: %v", err)
+		}
+		return req.Header.Get("X-Gateway-Signature")
+	}
+	first, second := sign(`{"amount":"100"}`), sign(`{"amount":"200"}`)
+	if first == "" || second == "" {
+		t.Fatal("signature header not set")
+	}
+	if first == second {
+		t.Error("signatures are equal for different bodies")
+	}
+}
+
+func TestHMACPreprocessorHeaderReachesServer(t *testing.T) {
+	const secret = "gw-secret"
+	var gotSig, gotURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get("X-Gateway-Signature")
+		gotURL = r.URL.String()
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0),
+		WithRequestPreprocessor(HMACPreprocessor(secret, "X-Gateway-Signature")))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(http.MethodGet + srv.URL + gotURL))
+	if want := hex.EncodeToString(mac.Sum(nil)); gotSig != want {
+		t.Errorf("signature = %q, want %q", gotSig, want)
+	}
+}
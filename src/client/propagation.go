@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// ContextHeaderKey maps a context value onto an outgoing request header,
+// so application-level correlation IDs reach GSPAY2.
+type ContextHeaderKey struct {
+	// Header is the request header the value is sent as.
+	Header string
+	// ContextKey is the key the value is read from with ctx.Value.
+	ContextKey string
+}
+
+// TenantIDPropagation builds the mapping for a tenant identifier header,
+// conventionally "X-Tenant-ID".
+func TenantIDPropagation(header, contextKey string) ContextHeaderKey {
+	return ContextHeaderKey{Header: header, ContextKey: contextKey}
+}
+
+// headerNameRe matches the header names accepted by WithContextPropagation.
+var headerNameRe = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// WithContextPropagation forwards context values as request headers. Each
+// mapping reads ctx.Value(mapping.ContextKey) per request; string values
+// that are non-empty are set under mapping.Header. Invalid header names
+// surface as errors.ErrInvalidConfiguration from the first request, and
+// context keys that look credential-like are logged as a warning.
+func WithContextPropagation(mappings ...ContextHeaderKey) Option {
+	return func(c *Client) { c.ctxHeaders = append(c.ctxHeaders, mappings...) }
+}
+
+// applyContextPropagation validates the configured mappings after all
+// options have been applied, so the warning reaches a logger configured
+// in any option order.
+func (c *Client) applyContextPropagation() {
+	for _, m := range c.ctxHeaders {
+		if !headerNameRe.MatchString(m.Header) {
+			if c.configErr == nil {
+				c.configErr = c.Error(errors.ErrInvalidConfiguration, fmt.Sprintf("context propagation header %q", m.Header))
+			}
+			continue
+		}
+		lower := strings.ToLower(m.ContextKey)
+		if strings.Contains(lower, "secret") || strings.Contains(lower, "key") {
+			c.logger.Warn(c.I18n(i18n.LogSensitiveContextKey), "contextKey", m.ContextKey, "header", m.Header)
+		}
+	}
+}
+
+// propagateContextHeaders sets the configured context values on the
+// request headers. Missing or non-string values set nothing.
+func (c *Client) propagateContextHeaders(ctx context.Context, set func(key, value string)) {
+	for _, m := range c.ctxHeaders {
+		if !headerNameRe.MatchString(m.Header) {
+			continue
+		}
+		if v, ok := ctx.Value(m.ContextKey).(string); ok && v != "" {
+			set(m.Header, v)
+		}
+	}
+}
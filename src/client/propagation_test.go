@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestContextPropagationSetsHeaders(t *testing.T) {
+	var gotTenant, gotUser string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTenant = r.Header.Get("X-Tenant-ID")
+		gotUser = r.Header.Get("X-User-ID")
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithContextPropagation(
+			TenantIDPropagation("X-Tenant-ID", "tenant_id"),
+			ContextHeaderKey{Header: "X-User-ID", ContextKey: "user_id"},
+		))
+	ctx := context.WithValue(context.Background(), "tenant_id", "tenant-42") //nolint:staticcheck
+	var out struct{}
+	if err := c.Get(ctx, "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if gotTenant != "tenant-42" {
+		t.Errorf("X-Tenant-ID = %q, want tenant-42", gotTenant)
+	}
+	if gotUser != "" {
+		t.Errorf("X-User-ID = %q, want unset for missing context value", gotUser)
+	}
+}
+
+func TestContextPropagationRejectsInvalidHeaderName(t *testing.T) {
+	c := New("auth", "secret",
+		WithContextPropagation(ContextHeaderKey{Header: "X-Bad Header\n", ContextKey: "tenant_id"}))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); !errors.Is(err, errors.ErrInvalidConfiguration) {
+		t.Errorf("Get error = %v, want ErrInvalidConfiguration", err)
+	}
+}
+
+func TestContextPropagationWarnsOnSensitiveKeys(t *testing.T) {
+	ml := &mockLogger{}
+	New("auth", "secret",
+		WithLogger(ml),
+		WithContextPropagation(ContextHeaderKey{Header: "X-Api-Key", ContextKey: "api_secret_key"}))
+	var warned bool
+	for _, e := range ml.entries {
+		if e.level == "warn" {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Error("no warning logged for credential-like context key")
+	}
+}
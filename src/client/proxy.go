@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// WithProxyURL routes all requests through the given HTTP proxy, e.g.
+// "http://proxy.internal:3128". Invalid URLs are ignored with a logged
+// warning.
+func WithProxyURL(proxyURL string) Option {
+	return func(c *Client) {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			c.proxyInvalid = proxyURL
+			return
+		}
+		c.proxy = http.ProxyURL(u)
+	}
+}
+
+// WithProxyFromEnvironment routes requests through the proxy configured
+// via the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables.
+func WithProxyFromEnvironment() Option {
+	return func(c *Client) { c.proxy = http.ProxyFromEnvironment }
+}
+
+// applyProxy sets the proxy on the HTTP client's transport, creating one
+// when the client has none. Custom transports that are not an
+// *http.Transport cannot be modified and are left alone with a warning.
+func (c *Client) applyProxy() {
+	switch transport := c.HTTPClient.Transport.(type) {
+	case nil:
+		c.HTTPClient.Transport = &http.Transport{Proxy: c.proxy}
+	case *http.Transport:
+		transport.Proxy = c.proxy
+	default:
+		c.logger.Warn(c.I18n(i18n.LogProxyIgnored))
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithProxyURLRoutesThroughProxy(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxied plain-HTTP request carries the absolute target URL.
+		if r.URL.IsAbs() {
+			proxied = true
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer proxy.Close()
+
+	c := New("auth", "secret",
+		WithBaseURL("http://api.gspay2.invalid"),
+		WithRetries(0),
+		WithProxyURL(proxy.URL))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if !proxied {
+		t.Error("request did not pass through the proxy")
+	}
+}
+
+func TestWithProxyURLModifiesCustomTransport(t *testing.T) {
+	transport := &http.Transport{}
+	c := New("auth", "secret",
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithProxyURL("http://proxy.internal:3128"))
+	if transport.Proxy == nil {
+		t.Error("custom *http.Transport proxy was not set")
+	}
+	if c.HTTPClient.Transport != http.RoundTripper(transport) {
+		t.Error("custom transport was replaced")
+	}
+}
+
+func TestWithProxyURLSkipsNonHTTPTransport(t *testing.T) {
+	log := &mockLogger{}
+	mock := NewMockTransport(MockResponse{Body: map[string]any{}})
+	c := New("auth", "secret",
+		WithLogger(log),
+		WithMockTransport(mock),
+		WithProxyURL("http://proxy.internal:3128"))
+	if _, ok := c.HTTPClient.Transport.(*MockTransport); !ok {
+		t.Fatalf("transport is %T, want *MockTransport", c.HTTPClient.Transport)
+	}
+	if len(log.entries) == 0 || log.entries[0].level != "warn" {
+		t.Errorf("entries = %v, want a warning", log.entries)
+	}
+}
+
+func TestWithProxyURLInvalid(t *testing.T) {
+	log := &mockLogger{}
+	c := New("auth", "secret", WithLogger(log), WithProxyURL("::bad::"))
+	if c.proxy != nil {
+		t.Error("invalid proxy URL was applied")
+	}
+	if len(log.entries) == 0 || log.entries[0].level != "warn" {
+		t.Errorf("entries = %v, want a warning", log.entries)
+	}
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter gates outgoing requests. Wait blocks until a request may
+// proceed or the context is cancelled. Implementations must be safe for
+// concurrent use; a Redis-backed distributed limiter can be injected via
+// WithCustomRateLimiter.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// WithRateLimiter throttles outgoing requests to rps requests per second
+// with the given burst, using a token bucket. Passing a non-positive rps
+// or burst disables limiting.
+func WithRateLimiter(rps float64, burst int) Option {
+	return func(c *Client) {
+		if rps <= 0 || burst <= 0 {
+			c.rateLimiter = nil
+			return
+		}
+		c.rateLimiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// WithCustomRateLimiter installs a user-supplied limiter implementation.
+func WithCustomRateLimiter(l RateLimiter) Option {
+	return func(c *Client) { c.rateLimiter = l }
+}
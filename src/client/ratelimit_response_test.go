@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestRateLimitedResponseCarriesRetryAfter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "17")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0))
+	var out struct{}
+	err := c.Get(context.Background(), "/probe", &out)
+	if !errors.Is(err, errors.ErrRateLimited) {
+		t.Fatalf("Get error = %v, want ErrRateLimited", err)
+	}
+	re := errors.GetRateLimitError(err)
+	if re == nil {
+		t.Fatal("GetRateLimitError = nil, want the rate limit details")
+	}
+	if re.RetryAfter != 17*time.Second {
+		t.Errorf("RetryAfter = %v, want 17s from the Retry-After header", re.RetryAfter)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got := parseRetryAfter(""); got != 0 {
+		t.Errorf("parseRetryAfter(\"\") = %v, want 0", got)
+	}
+	if got := parseRetryAfter("5"); got != 5*time.Second {
+		t.Errorf("parseRetryAfter(5) = %v, want 5s", got)
+	}
+	if got := parseRetryAfter("not a delay"); got != 0 {
+		t.Errorf("parseRetryAfter(garbage) = %v, want 0", got)
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if got := parseRetryAfter(date); got <= 0 || got > time.Minute {
+		t.Errorf("parseRetryAfter(%q) = %v, want a positive duration up to 1m", date, got)
+	}
+}
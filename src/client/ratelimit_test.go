@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func okServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRateLimiterDelaysRequests(t *testing.T) {
+	srv := okServer(t)
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithRateLimiter(10, 1))
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.DoRequest(context.Background(), http.MethodGet, "/x", nil); err != nil {
+			t.Fatalf("DoRequest: %v", err)
+		}
+	}
+	// With 10 rps and burst 1, the second and third requests each wait
+	// roughly 100ms for a token.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("three requests took %v, want at least 150ms under the limiter", elapsed)
+	}
+}
+
+func TestRateLimiterContextCancellation(t *testing.T) {
+	srv := okServer(t)
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithRateLimiter(0.1, 1))
+	// Drain the single burst token.
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/x", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.DoRequest(ctx, http.MethodGet, "/x", nil); err == nil {
+		t.Fatal("DoRequest returned nil error while waiting for a token with an expiring context")
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	srv := okServer(t)
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithRateLimiter(0, 0))
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := c.DoRequest(context.Background(), http.MethodGet, "/x", nil); err != nil {
+			t.Fatalf("DoRequest: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("disabled limiter still throttled: %v", elapsed)
+	}
+}
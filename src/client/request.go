@@ -0,0 +1,449 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/gc"
+	"github.com/nikon1313/gspay-go-sdk/src/internal/sanitize"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Response is the GSPAY2 response envelope. Data holds the
+// endpoint-specific payload and is decoded by the calling service.
+type Response struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+	// RequestID is the correlation ID for this call: the response's
+	// X-Request-ID header when present, otherwise the generated outgoing
+	// ID. Empty when neither exists.
+	RequestID string `json:"-"`
+	// Header holds the HTTP response headers for callers that need
+	// response metadata beyond the envelope.
+	Header http.Header `json:"-"`
+}
+
+// userAgent identifies the SDK in outgoing requests.
+const userAgent = "gspay-go-sdk/3.7"
+
+// DefaultMaxResponseBodySize is the response body size limit applied when
+// WithResponseBodySizeLimit is not used: 10 MiB.
+const DefaultMaxResponseBodySize int64 = 10 * 1024 * 1024
+
+// WithResponseBodySizeLimit caps the number of response body bytes the
+// client reads. Larger responses fail with errors.ErrResponseTooLarge.
+// Non-positive values restore the default.
+func WithResponseBodySizeLimit(n int64) Option {
+	return func(c *Client) {
+		if n <= 0 {
+			n = DefaultMaxResponseBodySize
+		}
+		c.maxResponseBody = n
+	}
+}
+
+// MaxResponseBodySize returns the configured response body size limit.
+func (c *Client) MaxResponseBodySize() int64 { return c.maxResponseBody }
+
+// DefaultJitterFactor is the share of the backoff interval randomized on
+// each retry to avoid thundering herds. Override it with WithJitterFactor.
+const DefaultJitterFactor = 0.25
+
+// Post sends a JSON body to the endpoint and decodes the response Data
+// payload into out when out is non-nil.
+func (c *Client) Post(ctx context.Context, endpoint string, body, out any) error {
+	resp, err := c.DoRequest(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return err
+	}
+	return c.decodeData(resp, out)
+}
+
+// PostWithHeaders behaves like Post but adds the given headers to the
+// request and returns the response envelope for callers that need
+// response metadata.
+func (c *Client) PostWithHeaders(ctx context.Context, endpoint string, body, out any, headers http.Header) (*Response, error) {
+	resp, err := c.DoRequestWithHeaders(ctx, http.MethodPost, endpoint, body, headers)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.decodeData(resp, out); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Get requests the endpoint and decodes the response Data payload into out
+// when out is non-nil. Query parameters must already be encoded into
+// endpoint.
+func (c *Client) Get(ctx context.Context, endpoint string, out any) error {
+	resp, err := c.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	return c.decodeData(resp, out)
+}
+
+func (c *Client) decodeData(resp *Response, out any) error {
+	if out == nil {
+		return nil
+	}
+	if len(resp.Data) == 0 {
+		return c.Error(errors.ErrInvalidJSON, "response has no data payload")
+	}
+	if err := json.Unmarshal(resp.Data, out); err != nil {
+		return c.Error(errors.ErrInvalidJSON, err)
+	}
+	return nil
+}
+
+// DoRequest executes one logical API call with retries and returns the
+// parsed response envelope. The endpoint is joined to BaseURL and must
+// already contain the formatted auth key.
+func (c *Client) DoRequest(ctx context.Context, method, endpoint string, body any) (*Response, error) {
+	return c.DoRequestWithHeaders(ctx, method, endpoint, body, nil)
+}
+
+// DoRequestWithHeaders behaves like DoRequest but adds the given headers
+// to the outgoing request.
+func (c *Client) DoRequestWithHeaders(ctx context.Context, method, endpoint string, body any, headers http.Header) (*Response, error) {
+	if c.configErr != nil {
+		return nil, c.configErr
+	}
+	release, err := c.acquireInFlight()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	releaseSlot, err := c.acquireSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseSlot()
+	if c.tlsErr != nil {
+		return nil, c.tlsErr
+	}
+	if err := c.checkCircuit(); err != nil {
+		return nil, err
+	}
+	if resp, ok := c.cachedResponse(method, endpoint); ok {
+		return resp, nil
+	}
+	resp, reqErr := c.doRequest(ctx, method, endpoint, body, headers)
+	c.recordCircuit(reqErr)
+	if reqErr == nil {
+		c.storeResponse(method, endpoint, resp)
+	}
+	return resp, reqErr
+}
+
+// prepareRequestBody marshals the request body and, when request body
+// compression is configured, compresses the result so every retry attempt
+// resends the same bytes.
+func (c *Client) prepareRequestBody(body any) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, c.Error(errors.ErrInvalidJSON, err)
+	}
+	if c.compression != CompressionNone {
+		return c.compressPayload(payload)
+	}
+	return payload, nil
+}
+
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body any, headers http.Header) (*Response, error) {
+	ctx, cancelTimeout := c.contextWithRequestTimeout(ctx, endpoint)
+	defer cancelTimeout()
+	payload, err := c.prepareRequestBody(body)
+	if err != nil {
+		return nil, err
+	}
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	headers = c.withIdempotencyKey(method, endpoint, payload, headers)
+	requestID := c.newRequestID()
+	ctx, span := c.StartSpan(ctx, "gspay.http.request",
+		attribute.String("http.method", method),
+		attribute.String("http.url", c.BaseURL+sanitize.Endpoint(endpoint)),
+		attribute.Int("gspay.retries", c.Retries))
+	defer span.End()
+	c.logger.Debug("sending request",
+		requestLogKV(requestID, "method", method, "endpoint", c.LogEndpoint(endpoint))...)
+	start := time.Now()
+	httpResp, attempts, err := c.executeWithRetry(ctx, method, endpoint, payload, requestID, headers)
+	statusCode := 0
+	if err == nil {
+		statusCode = httpResp.StatusCode
+	}
+	duration := time.Since(start)
+	c.observeRequest(method, endpoint, statusCode, attempts, duration)
+	if c.onComplete != nil {
+		c.onComplete(method, endpoint, statusCode, duration, err)
+	}
+	span.SetAttributes(attribute.Int("gspay.attempt", attempts))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", httpResp.StatusCode))
+	resp, err := c.processResponse(httpResp, endpoint, requestID)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return resp, err
+}
+
+// requestLogKV prepends the correlation ID to a log key-value list when
+// one is set for the request.
+func requestLogKV(requestID string, kv ...any) []any {
+	if requestID == "" {
+		return kv
+	}
+	return append([]any{"requestID", requestID}, kv...)
+}
+
+// executeWithRetry performs the HTTP request, retrying transient failures
+// (network errors, 5xx, 404 and 429) with exponential backoff.
+func (c *Client) executeWithRetry(ctx context.Context, method, endpoint string, payload []byte, requestID string, headers http.Header) (*http.Response, int, error) {
+	var lastErr error
+	var lastResp *http.Response
+	attempt := 0
+	for ; attempt <= c.Retries; attempt++ {
+		if attempt > 0 {
+			if c.onRetry != nil {
+				c.onRetry(attempt, lastResp, lastErr)
+			}
+			wait := c.waitBackoff(attempt)
+			c.logger.Warn("retrying request",
+				requestLogKV(requestID,
+					"attempt", attempt,
+					"endpoint", c.LogEndpoint(endpoint),
+					"wait", wait.String())...)
+			select {
+			case <-ctx.Done():
+				return nil, attempt, ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+		req, err := c.createHTTPRequest(ctx, method, endpoint, payload, requestID, headers)
+		if err != nil {
+			return nil, attempt, err
+		}
+		attemptStart := time.Now()
+		resp, err := c.HTTPClient.Do(req)
+		c.auditAttempt(method, endpoint, requestID, attempt, attemptStart, resp)
+		if err != nil {
+			lastErr = c.wrapNetworkError(err)
+			lastResp = nil
+			if c.retryCondition != nil && !c.retryCondition(nil, lastErr, nil) {
+				return nil, attempt, lastErr
+			}
+			continue
+		}
+		retry := shouldRetry(resp.StatusCode)
+		if c.retryCondition != nil {
+			retry = c.evalRetryCondition(resp)
+		}
+		if !retry || attempt == c.Retries {
+			return resp, attempt, nil
+		}
+		lastErr = c.Error(errors.ErrRequestFailed, fmt.Sprintf("status %d", resp.StatusCode))
+		lastResp = resp
+		drainBody(resp)
+	}
+	c.logger.Error("request failed after retries",
+		requestLogKV(requestID, "endpoint", c.LogEndpoint(endpoint), "error", lastErr)...)
+	return nil, attempt, lastErr
+}
+
+// wrapNetworkError maps common transport failures onto their dedicated
+// sentinels so callers can match them with errors.Is.
+func (c *Client) wrapNetworkError(err error) error {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return c.Error(errors.ErrTimeout, err)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return c.Error(errors.ErrConnectionRefused, err)
+	}
+	return c.Error(errors.ErrRequestFailed, err)
+}
+
+// evalRetryCondition buffers the response body, decodes the envelope for
+// the custom retry condition and restores the body for the caller.
+func (c *Client) evalRetryCondition(resp *http.Response) bool {
+	body, readErr := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBody+1))
+	resp.Body.Close()
+	var parsed *Response
+	if readErr == nil {
+		var envelope Response
+		if json.Unmarshal(body, &envelope) == nil {
+			parsed = &envelope
+		}
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return c.retryCondition(resp, nil, parsed)
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode >= 500 || statusCode == http.StatusNotFound || statusCode == http.StatusTooManyRequests
+}
+
+// waitBackoff returns the wait before the given retry attempt: exponential
+// growth from RetryWaitMin capped at RetryWaitMax, with a ±JitterFactor
+// share of the interval randomized.
+func (c *Client) waitBackoff(attempt int) time.Duration {
+	wait := c.RetryWaitMin << uint(attempt-1)
+	if wait > c.RetryWaitMax || wait <= 0 {
+		wait = c.RetryWaitMax
+	}
+	jitter := time.Duration(float64(wait) * c.JitterFactor)
+	if jitter > 0 {
+		wait = wait - jitter + time.Duration(rand.Int63n(int64(2*jitter)))
+	}
+	return wait
+}
+
+// createHTTPRequest builds a single HTTP request with standard headers.
+func (c *Client) createHTTPRequest(ctx context.Context, method, endpoint string, payload []byte, requestID string, headers http.Header) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+endpoint, body)
+	if err != nil {
+		return nil, c.Error(errors.ErrRequestFailed, err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+		if c.compression != CompressionNone {
+			req.Header.Set("Content-Encoding", string(c.compression))
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	if requestID != "" {
+		req.Header.Set("X-Request-ID", requestID)
+	}
+	c.setCustomHeaders(req.Header.Set)
+	c.propagateContextHeaders(ctx, req.Header.Set)
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	c.injectTraceContext(ctx, propagation.HeaderCarrier(req.Header))
+	if c.preprocessor != nil {
+		if err := c.preprocessor(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// parseRetryAfter converts a Retry-After header value — delay seconds or
+// an HTTP date — into a duration. Unparseable values yield zero.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// processResponse reads and parses the response body, mapping HTTP errors
+// to the SDK error types.
+func (c *Client) processResponse(resp *http.Response, endpoint string, requestID string) (*Response, error) {
+	defer resp.Body.Close()
+	if respID := responseRequestID(resp.Header); respID != "" {
+		requestID = respID
+	}
+	buf := gc.Default.Get()
+	defer gc.Default.Put(buf)
+	if _, err := buf.ReadFrom(io.LimitReader(resp.Body, c.maxResponseBody+1)); err != nil {
+		return nil, c.Error(errors.ErrRequestFailed, err)
+	}
+	if int64(buf.Len()) > c.maxResponseBody {
+		return nil, c.Error(errors.ErrResponseTooLarge)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, errors.NewRateLimitError(c.Lang(),
+			parseRetryAfter(resp.Header.Get("Retry-After")), c.LogEndpoint(endpoint))
+	}
+	body := buf.Bytes()
+	if c.responseTransformer != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		transformed, err := c.responseTransformer(body)
+		if err != nil {
+			return nil, c.Error(errors.ErrInvalidJSON, err)
+		}
+		body = transformed
+	}
+	var parsed Response
+	bodyErr := json.Unmarshal(body, &parsed)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg := parsed.Message
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+		return nil, &errors.APIError{
+			Code:      resp.StatusCode,
+			Message:   msg,
+			Endpoint:  c.LogEndpoint(endpoint),
+			RequestID: requestID,
+			Lang:      c.Lang(),
+		}
+	}
+	if bodyErr != nil {
+		return nil, c.Error(errors.ErrInvalidJSON, bodyErr)
+	}
+	parsed.RequestID = requestID
+	parsed.Header = resp.Header
+	return &parsed, nil
+}
+
+// correlationHeaders lists the response headers GSPAY2 may use for its
+// request ID, in preference order.
+var correlationHeaders = []string{"X-Request-ID", "X-Trace-ID", "X-Correlation-ID"}
+
+// responseRequestID returns the first correlation ID found in the response
+// headers, or an empty string.
+func responseRequestID(h http.Header) string {
+	for _, name := range correlationHeaders {
+		if id := h.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func drainBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestResponseBodySizeLimitDefault(t *testing.T) {
+	c := New("auth", "secret")
+	if got := c.MaxResponseBodySize(); got != DefaultMaxResponseBodySize {
+		t.Errorf("MaxResponseBodySize = %d, want %d", got, DefaultMaxResponseBodySize)
+	}
+}
+
+func TestResponseBodySizeLimitUnderLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithResponseBodySizeLimit(1024))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+}
+
+func TestResponseBodySizeLimitExceeded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"` + strings.Repeat("x", 2048) + `","data":{}}`))
+	}))
+	defer srv.Close()
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithResponseBodySizeLimit(1024))
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if !errors.Is(err, errors.ErrResponseTooLarge) {
+		t.Fatalf("error = %v, want ErrResponseTooLarge", err)
+	}
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// WithRequestIDGenerator injects a correlation ID into every outgoing
+// request as an X-Request-ID header. The ID appears in the request logs
+// and on APIError.RequestID so API calls can be correlated with
+// application logs. Use UUIDRequestIDGenerator unless the application has
+// its own ID scheme.
+func WithRequestIDGenerator(gen func() string) Option {
+	return func(c *Client) { c.requestIDGen = gen }
+}
+
+// UUIDRequestIDGenerator returns a random version 4 UUID built from
+// crypto/rand.
+func UUIDRequestIDGenerator() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// newRequestID returns a fresh correlation ID, or an empty string when no
+// generator is configured.
+func (c *Client) newRequestID() string {
+	if c.requestIDGen == nil {
+		return ""
+	}
+	return c.requestIDGen()
+}
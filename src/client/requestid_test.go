@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+)
+
+type logEntry struct {
+	level string
+	msg   string
+	kv    []any
+}
+
+type mockLogger struct {
+	entries []logEntry
+}
+
+func (l *mockLogger) record(level, msg string, kv []any) {
+	l.entries = append(l.entries, logEntry{level: level, msg: msg, kv: kv})
+}
+
+func (l *mockLogger) Debug(msg string, kv ...any) { l.record("debug", msg, kv) }
+func (l *mockLogger) Info(msg string, kv ...any)  { l.record("info", msg, kv) }
+func (l *mockLogger) Warn(msg string, kv ...any)  { l.record("warn", msg, kv) }
+func (l *mockLogger) Error(msg string, kv ...any) { l.record("error", msg, kv) }
+
+func (l *mockLogger) hasKV(key string, value any) bool {
+	for _, e := range l.entries {
+		for i := 0; i+1 < len(e.kv); i += 2 {
+			if e.kv[i] == key && e.kv[i+1] == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var _ logger.Handler = (*mockLogger)(nil)
+
+func TestRequestIDGeneratorSendsHeaderAndLogs(t *testing.T) {
+	var gotHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Request-ID")
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	log := &mockLogger{}
+	c := New("auth-key", "secret-key",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithLogger(log),
+		WithRequestIDGenerator(func() string { return "req-123" }))
+
+	resp, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	if err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if gotHeader != "req-123" {
+		t.Errorf("X-Request-ID header = %q, want req-123", gotHeader)
+	}
+	if resp.RequestID != "req-123" {
+		t.Errorf("Response.RequestID = %q, want req-123", resp.RequestID)
+	}
+	if !log.hasKV("requestID", "req-123") {
+		t.Error("requestID missing from log output")
+	}
+}
+
+func TestRequestIDCapturedFromResponseHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "srv-456")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"bad request"}`))
+	}))
+	defer srv.Close()
+	c := New("auth-key", "secret-key", WithBaseURL(srv.URL), WithRetries(0))
+
+	_, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+	ae := errors.GetAPIError(err)
+	if ae == nil {
+		t.Fatalf("error = %v, want *APIError", err)
+	}
+	if ae.RequestID != "srv-456" {
+		t.Errorf("APIError.RequestID = %q, want srv-456", ae.RequestID)
+	}
+}
+
+func TestUUIDRequestIDGenerator(t *testing.T) {
+	pattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	first := UUIDRequestIDGenerator()
+	if !pattern.MatchString(first) {
+		t.Errorf("UUIDRequestIDGenerator() = %q, not a v4 UUID", first)
+	}
+	if second := UUIDRequestIDGenerator(); second == first {
+		t.Error("two generated IDs are identical")
+	}
+}
+
+func TestRequestIDCapturedFromAlternateHeaders(t *testing.T) {
+	for _, header := range []string{"X-Request-ID", "X-Trace-ID", "X-Correlation-ID"} {
+		header := header
+		t.Run(header, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(header, "corr-789")
+				w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+			}))
+			defer srv.Close()
+			c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0))
+			resp, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil)
+			if err != nil {
+				t.Fatalf("DoRequest: %v", err)
+			}
+			if resp.RequestID != "corr-789" {
+				t.Errorf("Response.RequestID = %q, want corr-789", resp.RequestID)
+			}
+		})
+	}
+}
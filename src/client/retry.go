@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+)
+
+// RetryCondition decides whether a failed or suspicious attempt should be
+// retried. httpResp is the raw HTTP response and is nil when the attempt
+// failed at the network level, in which case err carries the (wrapped)
+// transport error. parsed is the decoded response envelope, nil when the
+// body could not be decoded. The final attempt's result is returned to
+// the caller regardless of what the condition says.
+type RetryCondition func(httpResp *http.Response, err error, parsed *Response) bool
+
+// DefaultRetryCondition is the built-in behavior: retry network errors
+// and responses with status 5xx, 404 or 429.
+func DefaultRetryCondition(httpResp *http.Response, err error, _ *Response) bool {
+	if httpResp == nil {
+		return err != nil
+	}
+	return shouldRetry(httpResp.StatusCode)
+}
+
+// RetryOn5xxAndRateLimit retries network errors, 5xx responses and 429,
+// but not 404 — for deployments where a missing resource is final.
+func RetryOn5xxAndRateLimit(httpResp *http.Response, err error, _ *Response) bool {
+	if httpResp == nil {
+		return err != nil
+	}
+	return httpResp.StatusCode >= 500 || httpResp.StatusCode == http.StatusTooManyRequests
+}
+
+// RetryOnAPIErrorCode returns a condition that additionally retries
+// responses whose envelope Code is one of codes, on top of the default
+// behavior. It covers gateways that answer HTTP 200 with an error body.
+func RetryOnAPIErrorCode(codes ...int) RetryCondition {
+	return func(httpResp *http.Response, err error, parsed *Response) bool {
+		if DefaultRetryCondition(httpResp, err, parsed) {
+			return true
+		}
+		if parsed == nil {
+			return false
+		}
+		for _, code := range codes {
+			if parsed.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// WithRetryCondition replaces the built-in retry condition. Setting a
+// condition makes the client decode the response body on every attempt so
+// the condition can inspect the envelope, at the cost of buffering
+// responses that would otherwise stream straight to the parser.
+func WithRetryCondition(fn RetryCondition) Option {
+	return func(c *Client) { c.retryCondition = fn }
+}
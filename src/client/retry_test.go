@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestRetryConditionOverridesDefault(t *testing.T) {
+	var hits atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	// The default condition retries 404; RetryOn5xxAndRateLimit does not.
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(3),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+		WithRetryCondition(RetryOn5xxAndRateLimit))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err == nil {
+		t.Fatal("Get succeeded, want an error for the 404")
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hits = %d, want 1 without a retry", got)
+	}
+}
+
+func TestRetryOnAPIErrorCode(t *testing.T) {
+	var hits atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			w.Write([]byte(`{"code":502,"message":"upstream hiccup","data":null}`))
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(3),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+		WithRetryCondition(RetryOnAPIErrorCode(502)))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hits = %d, want 2: the HTTP 200 error body retried once", got)
+	}
+}
+
+func TestRetryConditionSeesNetworkError(t *testing.T) {
+	var gotNilResp atomic.Bool
+	var calls atomic.Int64
+	c := New("auth", "secret", WithBaseURL("http://127.0.0.1:1"), WithRetries(2),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+		WithRetryCondition(func(httpResp *http.Response, err error, parsed *Response) bool {
+			calls.Add(1)
+			gotNilResp.Store(httpResp == nil && parsed == nil && err != nil)
+			return false
+		}))
+	var out struct{}
+	err := c.Get(context.Background(), "/probe", &out)
+	if !errors.Is(err, errors.ErrConnectionRefused) && !errors.Is(err, errors.ErrRequestFailed) {
+		t.Fatalf("Get error = %v, want a wrapped network error", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("condition calls = %d, want 1: returning false stops retrying", calls.Load())
+	}
+	if !gotNilResp.Load() {
+		t.Errorf("condition did not receive nil response, nil envelope and a non-nil error")
+	}
+}
+
+func TestDefaultRetryConditionMatchesBuiltIn(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusInternalServerError, true},
+		{http.StatusNotFound, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusBadRequest, false},
+		{http.StatusOK, false},
+	} {
+		resp := &http.Response{StatusCode: tc.status}
+		if got := DefaultRetryCondition(resp, nil, nil); got != tc.want {
+			t.Errorf("DefaultRetryCondition(%d) = %v, want %v", tc.status, got, tc.want)
+		}
+	}
+	if !DefaultRetryCondition(nil, errors.ErrRequestFailed, nil) {
+		t.Error("DefaultRetryCondition(nil, err, nil) = false, want true")
+	}
+}
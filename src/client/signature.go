@@ -0,0 +1,31 @@
+package client
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	internalsig "github.com/nikon1313/gspay-go-sdk/src/internal/signature"
+	"github.com/nikon1313/gspay-go-sdk/src/signature"
+)
+
+// GenerateSignature computes the GSPAY2 signature over the concatenated
+// values followed by the operator secret key. The digest is MD5 unless a
+// custom one was configured with WithDigest.
+func (c *Client) GenerateSignature(values ...string) string {
+	data := strings.Join(values, "") + c.SecretKey
+	if c.digest != nil {
+		return signature.GenerateWithDigest(data, c.digest)
+	}
+	return internalsig.Generate(data)
+}
+
+// VerifySignature reports whether got is the valid signature for the
+// concatenated values followed by the operator secret key. The comparison
+// is constant time.
+func (c *Client) VerifySignature(got string, values ...string) bool {
+	want := c.GenerateSignature(values...)
+	if len(want) != len(got) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
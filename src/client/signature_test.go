@@ -0,0 +1,35 @@
+package client
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestGenerateSignatureDefaultMD5(t *testing.T) {
+	c := New("auth", "secret")
+	sum := md5.Sum([]byte("abc" + "secret"))
+	want := hex.EncodeToString(sum[:])
+	if got := c.GenerateSignature("a", "b", "c"); got != want {
+		t.Errorf("GenerateSignature = %q, want %q", got, want)
+	}
+	if !c.VerifySignature(want, "a", "b", "c") {
+		t.Error("VerifySignature rejected a valid MD5 signature")
+	}
+}
+
+func TestGenerateSignatureWithDigest(t *testing.T) {
+	c := New("auth", "secret", WithDigest(sha256.New))
+	sum := sha256.Sum256([]byte("abc" + "secret"))
+	want := hex.EncodeToString(sum[:])
+	if got := c.GenerateSignature("a", "b", "c"); got != want {
+		t.Errorf("GenerateSignature = %q, want %q", got, want)
+	}
+	if !c.VerifySignature(want, "a", "b", "c") {
+		t.Error("VerifySignature rejected a valid SHA-256 signature")
+	}
+	if c.VerifySignature(want+"00", "a", "b", "c") {
+		t.Error("VerifySignature accepted a tampered signature")
+	}
+}
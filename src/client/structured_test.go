@@ -0,0 +1,65 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/logger"
+)
+
+func TestWithStructuredLoggerMasksEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	c := New("auth-key-123", "secret", WithBaseURL(srv.URL),
+		WithStructuredLogger(logger.NewStructuredJSON(&buf)))
+	var out struct{}
+	if err := c.Get(context.Background(), "/v2/integrations/operators/auth-key-123/balance", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if strings.Contains(buf.String(), "auth-key-123") {
+		t.Errorf("log output %q contains the auth key, want it masked", buf.String())
+	}
+	var sawRequest bool
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var record map[string]any
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Fatalf("line %q is not valid JSON: %v", line, err)
+		}
+		if record["msg"] == "sending request" {
+			sawRequest = true
+			if endpoint, _ := record["endpoint"].(string); !strings.Contains(endpoint, "operators/****") {
+				t.Errorf("endpoint = %q, want the auth key masked", endpoint)
+			}
+		}
+	}
+	if !sawRequest {
+		t.Errorf("log output %q has no sending request record", buf.String())
+	}
+}
+
+func TestStructuredAndPlainLoggersAreExclusive(t *testing.T) {
+	var buf bytes.Buffer
+	New("auth", "secret",
+		WithLogger(logger.NewStd(&buf, logger.LevelDebug)),
+		WithStructuredLogger(logger.NewStructuredJSON(&buf)))
+	if !strings.Contains(buf.String(), "mutually exclusive") {
+		t.Errorf("log output %q, want a warning that the plain logger was replaced", buf.String())
+	}
+
+	buf.Reset()
+	New("auth", "secret",
+		WithStructuredLogger(logger.NewStructuredJSON(&buf)),
+		WithLogger(logger.NewStd(&buf, logger.LevelDebug)))
+	if !strings.Contains(buf.String(), "mutually exclusive") {
+		t.Errorf("log output %q, want a warning that the structured logger was replaced", buf.String())
+	}
+}
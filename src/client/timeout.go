@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// timeoutRule applies a timeout to the endpoints accepted by match. The
+// first matching rule wins.
+type timeoutRule struct {
+	match   func(endpoint string) bool
+	timeout time.Duration
+}
+
+// WithRequestTimeout overrides the request timeout for endpoints starting
+// with endpointPattern. Rules are checked in the order the options were
+// given; the first match wins. Endpoints without a matching rule keep the
+// HTTP client's global timeout.
+func WithRequestTimeout(endpointPattern string, timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeoutRules = append(c.timeoutRules, timeoutRule{
+			match:   func(endpoint string) bool { return strings.HasPrefix(endpoint, endpointPattern) },
+			timeout: timeout,
+		})
+	}
+}
+
+// WithStatusTimeout overrides the request timeout for all status
+// endpoints, which should answer faster than creation calls.
+func WithStatusTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeoutRules = append(c.timeoutRules, timeoutRule{
+			match:   func(endpoint string) bool { return strings.Contains(endpoint, "/status") },
+			timeout: timeout,
+		})
+	}
+}
+
+// contextWithRequestTimeout wraps ctx with the per-request timeout of the
+// first rule matching endpoint. Without a match the context is returned
+// unchanged with a no-op cancel.
+func (c *Client) contextWithRequestTimeout(ctx context.Context, endpoint string) (context.Context, context.CancelFunc) {
+	for _, rule := range c.timeoutRules {
+		if rule.match(endpoint) {
+			return context.WithTimeout(ctx, rule.timeout)
+		}
+	}
+	return ctx, func() {}
+}
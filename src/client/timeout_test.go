@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func newSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestWithStatusTimeoutAppliesToStatusEndpoints(t *testing.T) {
+	srv := newSlowServer(t, 300*time.Millisecond)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0),
+		WithStatusTimeout(50*time.Millisecond))
+	var out struct{}
+	if err := c.Get(context.Background(), "/idr/payment/status", &out); !errors.Is(err, errors.ErrTimeout) {
+		t.Errorf("status endpoint error = %v, want ErrTimeout", err)
+	}
+	// Non-status endpoints keep the global timeout and succeed.
+	if err := c.Get(context.Background(), "/idr/payment", &out); err != nil {
+		t.Errorf("create endpoint error = %v, want nil", err)
+	}
+}
+
+func TestWithRequestTimeoutPrefixMatch(t *testing.T) {
+	srv := newSlowServer(t, 300*time.Millisecond)
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0),
+		WithRequestTimeout("/fast", 50*time.Millisecond),
+		WithRequestTimeout("/slow", 2*time.Second))
+	var out struct{}
+	if err := c.Get(context.Background(), "/fast/probe", &out); !errors.Is(err, errors.ErrTimeout) {
+		t.Errorf("/fast error = %v, want ErrTimeout", err)
+	}
+	if err := c.Get(context.Background(), "/slow/probe", &out); err != nil {
+		t.Errorf("/slow error = %v, want nil", err)
+	}
+}
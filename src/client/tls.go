@@ -0,0 +1,48 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// WithTLSConfig applies the TLS configuration to the underlying
+// *http.Transport, e.g. for certificate pinning or outbound mTLS. An
+// existing *http.Transport is cloned so settings such as connection pool
+// sizes are preserved. When the transport is not an *http.Transport the
+// config cannot be applied and every request fails with the stored error.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) { c.tlsConfig = cfg }
+}
+
+// WithInsecureSkipVerify disables server certificate verification. It
+// exists for tests against self-signed endpoints; never enable it in
+// production.
+func WithInsecureSkipVerify(skip bool) Option {
+	return func(c *Client) {
+		if c.tlsConfig == nil {
+			c.tlsConfig = &tls.Config{}
+		}
+		c.tlsConfig.InsecureSkipVerify = skip
+	}
+}
+
+// applyTLSConfig installs the configured TLS settings on the HTTP
+// client's transport after New has resolved the transport options.
+func (c *Client) applyTLSConfig() {
+	if c.tlsConfig == nil {
+		return
+	}
+	switch transport := c.HTTPClient.Transport.(type) {
+	case nil:
+		c.HTTPClient.Transport = &http.Transport{TLSClientConfig: c.tlsConfig}
+	case *http.Transport:
+		cloned := transport.Clone()
+		cloned.TLSClientConfig = c.tlsConfig
+		c.HTTPClient.Transport = cloned
+	default:
+		c.tlsErr = c.Error(errors.ErrRequestFailed,
+			"TLS config cannot be applied: transport is not an *http.Transport")
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithTLSConfigAppliedToTransport(t *testing.T) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS13}
+	c := New("auth", "secret",
+		WithConnectionPool(ConnectionPoolConfig{MaxIdleConns: 7}),
+		WithTLSConfig(cfg))
+	transport, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("transport is %T, want *http.Transport", c.HTTPClient.Transport)
+	}
+	if transport.TLSClientConfig != cfg {
+		t.Error("TLSClientConfig was not applied")
+	}
+	if transport.MaxIdleConns != 7 {
+		t.Error("cloning dropped the connection pool settings")
+	}
+}
+
+func TestWithTLSConfigNonHTTPTransport(t *testing.T) {
+	mock := NewMockTransport(MockResponse{Body: map[string]any{}})
+	c := New("auth", "secret",
+		WithMockTransport(mock),
+		WithTLSConfig(&tls.Config{}))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err == nil {
+		t.Fatal("DoRequest: want stored TLS config error, got nil")
+	}
+}
+
+func TestWithTLSConfigAgainstTLSServer(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithTLSConfig(&tls.Config{RootCAs: pool}))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest over TLS: %v", err)
+	}
+}
+
+func TestWithInsecureSkipVerifyIPv6(t *testing.T) {
+	listener, err := net.Listen("tcp6", "[::1]:0")
+	if err != nil {
+		t.Skipf("IPv6 loopback unavailable: %v", err)
+	}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	srv.Listener.Close()
+	srv.Listener = listener
+	srv.StartTLS()
+	defer srv.Close()
+
+	c := New("auth", "secret",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithTimeout(5*time.Second),
+		WithInsecureSkipVerify(true))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest over IPv6 TLS: %v", err)
+	}
+}
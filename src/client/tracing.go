@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies this instrumentation scope.
+const tracerName = "github.com/nikon1313/gspay-go-sdk"
+
+// WithTracerProvider enables OpenTelemetry tracing. Every DoRequest emits
+// a "gspay.http.request" span with sanitized URL attributes, and the
+// trace context is propagated to GSPAY2 via W3C traceparent headers.
+// Service operations create parent spans named after the operation.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Client) {
+		if tp != nil {
+			c.tracer = tp.Tracer(tracerName)
+		}
+	}
+}
+
+// StartSpan opens a span with the given name when tracing is enabled.
+// Without a tracer provider it returns the context unchanged and a no-op
+// span, so callers can defer span.End() unconditionally.
+func (c *Client) StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if c.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return c.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// injectTraceContext writes W3C TraceContext headers for the active span
+// into the outgoing request headers.
+func (c *Client) injectTraceContext(ctx context.Context, carrier propagation.HeaderCarrier) {
+	if c.tracer == nil {
+		return
+	}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+}
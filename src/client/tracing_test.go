@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newRecordingClient(t *testing.T, handler http.HandlerFunc) (*Client, *tracetest.SpanRecorder) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { tp.Shutdown(context.Background()) })
+	c := New("auth-key", "secret-key",
+		WithBaseURL(srv.URL),
+		WithRetries(0),
+		WithTracerProvider(tp))
+	return c, recorder
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestDoRequestCreatesSpan(t *testing.T) {
+	var traceparent string
+	c, recorder := newRecordingClient(t, func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	})
+	endpoint := "/v2/integrations/operators/auth-key/idr/payment"
+	if _, err := c.DoRequest(context.Background(), http.MethodPost, endpoint, map[string]any{}); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "gspay.http.request" {
+		t.Errorf("span name = %q, want gspay.http.request", span.Name())
+	}
+	if v, ok := spanAttr(span, "http.method"); !ok || v.AsString() != http.MethodPost {
+		t.Errorf("http.method = %v, want POST", v.AsString())
+	}
+	if v, ok := spanAttr(span, "http.url"); !ok || v.AsString() != c.BaseURL+"/v2/integrations/operators/****/idr/payment" {
+		t.Errorf("http.url = %q, want sanitized URL", v.AsString())
+	}
+	if v, ok := spanAttr(span, "http.status_code"); !ok || v.AsInt64() != 200 {
+		t.Errorf("http.status_code = %v, want 200", v.AsInt64())
+	}
+	if v, ok := spanAttr(span, "gspay.attempt"); !ok || v.AsInt64() != 0 {
+		t.Errorf("gspay.attempt = %v, want 0", v.AsInt64())
+	}
+	if _, ok := spanAttr(span, "gspay.retries"); !ok {
+		t.Error("gspay.retries attribute missing")
+	}
+	if traceparent == "" {
+		t.Error("traceparent header not propagated to the server")
+	}
+}
+
+func TestDoRequestSpanErrorStatus(t *testing.T) {
+	c, recorder := newRecordingClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"bad request"}`))
+	})
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err == nil {
+		t.Fatal("DoRequest returned nil error for 400 response")
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("span status = %v, want Error", spans[0].Status().Code)
+	}
+}
+
+func TestDoRequestWithoutTracerProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") != "" {
+			t.Error("traceparent header set without a tracer provider")
+		}
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	defer srv.Close()
+	c := New("auth-key", "secret-key", WithBaseURL(srv.URL), WithRetries(0))
+	if _, err := c.DoRequest(context.Background(), http.MethodGet, "/v2/ping", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// ResponseTransformer rewrites a response body before JSON parsing, for
+// proxy setups that encrypt or compress GSPAY2 responses.
+type ResponseTransformer func(body []byte) ([]byte, error)
+
+// WithResponseTransformer runs fn on every successful (2xx) response body
+// before the envelope is parsed. HTTP error responses are passed through
+// untransformed, since proxies typically emit those themselves. A
+// transformer error surfaces as errors.ErrInvalidJSON.
+func WithResponseTransformer(fn ResponseTransformer) Option {
+	return func(c *Client) { c.responseTransformer = fn }
+}
+
+// GzipResponseTransformer returns a transformer that gunzips the body,
+// for proxies that compress without setting Content-Encoding.
+func GzipResponseTransformer() ResponseTransformer {
+	return func(body []byte) ([]byte, error) {
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	}
+}
+
+// IdentityTransformer returns a transformer that passes the body through
+// unchanged, as a neutral default for conditional wiring.
+func IdentityTransformer() ResponseTransformer {
+	return func(body []byte) ([]byte, error) { return body, nil }
+}
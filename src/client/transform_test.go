@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestResponseTransformerRewritesBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`obfuscated`))
+	}))
+	defer srv.Close()
+
+	var gotRaw []byte
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithResponseTransformer(func(body []byte) ([]byte, error) {
+			gotRaw = append([]byte(nil), body...)
+			return []byte(`{"code":200,"message":"OK","data":{"value":7}}`), nil
+		}))
+	var out struct {
+		Value int `json:"value"`
+	}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if string(gotRaw) != "obfuscated" {
+		t.Errorf("transformer input = %q, want the raw body", gotRaw)
+	}
+	if out.Value != 7 {
+		t.Errorf("decoded value = %d, want 7 from the transformed body", out.Value)
+	}
+}
+
+func TestGzipResponseTransformer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"code":200,"message":"OK","data":{"value":9}}`))
+		gz.Close()
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithResponseTransformer(GzipResponseTransformer()))
+	var out struct {
+		Value int `json:"value"`
+	}
+	if err := c.Get(context.Background(), "/probe", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if out.Value != 9 {
+		t.Errorf("decoded value = %d, want 9 from the gunzipped body", out.Value)
+	}
+}
+
+func TestResponseTransformerErrorIsInvalidJSON(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not gzip`))
+	}))
+	defer srv.Close()
+
+	c := New("auth", "secret", WithBaseURL(srv.URL),
+		WithResponseTransformer(func([]byte) ([]byte, error) {
+			return nil, fmt.Errorf("bad ciphertext")
+		}))
+	var out struct{}
+	if err := c.Get(context.Background(), "/probe", &out); !errors.Is(err, errors.ErrInvalidJSON) {
+		t.Errorf("Get error = %v, want ErrInvalidJSON", err)
+	}
+}
+
+func TestResponseTransformerSkippedOnHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"bad request"}`))
+	}))
+	defer srv.Close()
+
+	var called bool
+	c := New("auth", "secret", WithBaseURL(srv.URL), WithRetries(0),
+		WithResponseTransformer(func(body []byte) ([]byte, error) {
+			called = true
+			return body, nil
+		}))
+	var out struct{}
+	err := c.Get(context.Background(), "/probe", &out)
+	if errors.GetAPIError(err) == nil {
+		t.Fatalf("Get error = %v, want an APIError", err)
+	}
+	if called {
+		t.Error("transformer ran on an HTTP error response")
+	}
+}
+
+func TestIdentityTransformer(t *testing.T) {
+	body := []byte(`{"code":200}`)
+	out, err := IdentityTransformer()(body)
+	if err != nil || !bytes.Equal(out, body) {
+		t.Errorf("IdentityTransformer = %q, %v, want the input unchanged", out, err)
+	}
+}
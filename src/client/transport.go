@@ -0,0 +1,79 @@
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"golang.org/x/net/http2"
+)
+
+// ConnectionPoolConfig controls the connection pooling behaviour of the
+// HTTP transport built by WithConnectionPool. Zero values fall back to
+// Go's net/http defaults.
+type ConnectionPoolConfig struct {
+	// MaxIdleConns caps the total idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost caps the idle connections kept per host.
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost caps the total connections per host, including
+	// dialing, active and idle ones. Zero means no limit.
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept before
+	// closing it.
+	IdleConnTimeout time.Duration
+	// DisableKeepAlives forces a fresh connection for every request.
+	DisableKeepAlives bool
+}
+
+// WithConnectionPool builds the HTTP transport with the given connection
+// pool settings. Ignored with a logged warning when a custom HTTP client
+// is supplied via WithHTTPClient.
+func WithConnectionPool(cfg ConnectionPoolConfig) Option {
+	return func(c *Client) { c.poolConfig = &cfg }
+}
+
+// WithHTTP2 enables HTTP/2 on the transport built by New. It has no
+// effect when a custom HTTP client is supplied via WithHTTPClient.
+func WithHTTP2(enabled bool) Option {
+	return func(c *Client) { c.http2 = enabled }
+}
+
+// applyTransportOptions builds the HTTP client from WithConnectionPool
+// and WithHTTP2 after all options have been applied, so option order
+// does not matter.
+func (c *Client) applyTransportOptions() {
+	if c.proxyInvalid != "" {
+		c.logger.Warn(c.I18n(i18n.LogInvalidProxyURL), "proxyURL", c.proxyInvalid)
+	}
+	if c.poolConfig == nil && !c.http2 && c.proxy == nil {
+		return
+	}
+	if c.customHTTPClient {
+		if c.poolConfig != nil {
+			c.logger.Warn(c.I18n(i18n.LogConnectionPoolIgnored))
+		}
+		if c.proxy != nil {
+			c.applyProxy()
+		}
+		return
+	}
+	transport := &http.Transport{Proxy: c.proxy}
+	if cfg := c.poolConfig; cfg != nil {
+		transport.MaxIdleConns = cfg.MaxIdleConns
+		transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+		transport.IdleConnTimeout = cfg.IdleConnTimeout
+		transport.DisableKeepAlives = cfg.DisableKeepAlives
+	}
+	if c.http2 {
+		if err := http2.ConfigureTransport(transport); err != nil {
+			c.logger.Warn(c.I18n(i18n.LogHTTP2ConfigFailed), "error", err.Error())
+		}
+	}
+	timeout := DefaultTimeout
+	if c.HTTPClient != nil && c.HTTPClient.Timeout > 0 {
+		timeout = c.HTTPClient.Timeout
+	}
+	c.HTTPClient = &http.Client{Transport: transport, Timeout: timeout}
+}
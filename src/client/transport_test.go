@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithConnectionPool(t *testing.T) {
+	c := New("auth", "secret", WithConnectionPool(ConnectionPoolConfig{
+		MaxIdleConns:        50,
+		MaxIdleConnsPerHost: 10,
+		MaxConnsPerHost:     20,
+		IdleConnTimeout:     45 * time.Second,
+		DisableKeepAlives:   true,
+	}))
+	transport, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("transport is %T, want *http.Transport", c.HTTPClient.Transport)
+	}
+	if transport.MaxIdleConns != 50 {
+		t.Errorf("MaxIdleConns = %d, want 50", transport.MaxIdleConns)
+	}
+	if transport.MaxIdleConnsPerHost != 10 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want 10", transport.MaxIdleConnsPerHost)
+	}
+	if transport.MaxConnsPerHost != 20 {
+		t.Errorf("MaxConnsPerHost = %d, want 20", transport.MaxConnsPerHost)
+	}
+	if transport.IdleConnTimeout != 45*time.Second {
+		t.Errorf("IdleConnTimeout = %v, want 45s", transport.IdleConnTimeout)
+	}
+	if !transport.DisableKeepAlives {
+		t.Error("DisableKeepAlives = false, want true")
+	}
+}
+
+func TestWithConnectionPoolIgnoredWithCustomClient(t *testing.T) {
+	log := &mockLogger{}
+	custom := &http.Client{Timeout: time.Second}
+	c := New("auth", "secret",
+		WithLogger(log),
+		WithHTTPClient(custom),
+		WithConnectionPool(ConnectionPoolConfig{MaxIdleConns: 50}))
+	if c.HTTPClient != custom {
+		t.Error("custom HTTP client was replaced")
+	}
+	if len(log.entries) == 0 || log.entries[0].level != "warn" {
+		t.Errorf("entries = %v, want a warning", log.entries)
+	}
+}
+
+func TestWithHTTP2Negotiated(t *testing.T) {
+	var proto string
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proto = r.Proto
+		w.Write([]byte(`{"code":200,"message":"OK","data":{}}`))
+	}))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ts.Certificate())
+	c := New("auth", "secret",
+		WithBaseURL(ts.URL),
+		WithRetries(0),
+		WithConnectionPool(ConnectionPoolConfig{MaxIdleConns: 10}),
+		WithHTTP2(true))
+	transport := c.HTTPClient.Transport.(*http.Transport)
+	transport.TLSClientConfig.RootCAs = pool
+
+	var out map[string]any
+	if err := c.Get(context.Background(), "/v2/ping", &out); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if proto != "HTTP/2.0" {
+		t.Errorf("server saw %s, want HTTP/2.0", proto)
+	}
+}
@@ -0,0 +1,212 @@
+package constants
+
+import "strings"
+
+// BanksIDR maps Indonesian bank codes accepted by GSPAY2 to their
+// display names. E-wallets live in EWalletsIDR; payout validation
+// accepts codes from either map.
+var BanksIDR = map[string]string{
+	"BCA":      "Bank Central Asia",
+	"BRI":      "Bank Rakyat Indonesia",
+	"BNI":      "Bank Negara Indonesia",
+	"MANDIRI":  "Bank Mandiri",
+	"CIMB":     "CIMB Niaga",
+	"PERMATA":  "Bank Permata",
+	"DANAMON":  "Bank Danamon",
+	"BSI":      "Bank Syariah Indonesia",
+	"BTN":      "Bank Tabungan Negara",
+	"PANIN":    "Panin Bank",
+	"OCBC":     "OCBC NISP",
+	"MAYBANK":  "Maybank Indonesia",
+	"MEGA":     "Bank Mega",
+	"SINARMAS": "Bank Sinarmas",
+}
+
+// EWalletsIDR maps Indonesian e-wallet codes accepted by GSPAY2 to their
+// display names.
+var EWalletsIDR = map[string]string{
+	"DANA": "DANA",
+	"OVO":  "OVO",
+}
+
+// BanksTHB maps Thai bank codes accepted by GSPAY2 to their display names.
+var BanksTHB = map[string]string{
+	"BBL":   "Bangkok Bank",
+	"KBANK": "Kasikornbank",
+	"KTB":   "Krungthai Bank",
+	"SCB":   "Siam Commercial Bank",
+	"BAY":   "Bank of Ayudhya (Krungsri)",
+	"TTB":   "TMBThanachart Bank",
+	"GSB":   "Government Savings Bank",
+	"KKP":   "Kiatnakin Phatra Bank",
+	"CIMBT": "CIMB Thai",
+	"UOBT":  "United Overseas Bank (Thai)",
+}
+
+// BanksMYR maps Malaysian bank codes accepted by GSPAY2 to their display
+// names.
+var BanksMYR = map[string]string{
+	"MAYBANK":  "Malayan Banking Berhad",
+	"CIMB":     "CIMB Bank",
+	"PUBLIC":   "Public Bank Berhad",
+	"RHB":      "RHB Bank",
+	"HLB":      "Hong Leong Bank",
+	"AMBANK":   "AmBank",
+	"UOB":      "United Overseas Bank (Malaysia)",
+	"BSN":      "Bank Simpanan Nasional",
+	"OCBC":     "OCBC Bank (Malaysia)",
+	"HSBC":     "HSBC Bank Malaysia",
+	"SCBMY":    "Standard Chartered Malaysia",
+	"AFFIN":    "Affin Bank",
+	"ALLIANCE": "Alliance Bank",
+	"ISLAM":    "Bank Islam Malaysia",
+	"MUAMALAT": "Bank Muamalat Malaysia",
+	"RAKYAT":   "Bank Rakyat",
+	"CITIBANK": "Citibank Malaysia",
+	"KFH":      "Kuwait Finance House",
+	"AGROBANK": "Agrobank",
+	"ALRAJHI":  "Al Rajhi Bank Malaysia",
+}
+
+// banksByCurrency maps each bank-transfer currency to its bank map.
+var banksByCurrency = map[Currency]map[string]string{
+	CurrencyIDR: BanksIDR,
+	CurrencyTHB: BanksTHB,
+	CurrencyMYR: BanksMYR,
+}
+
+// IsValidBankIDR reports whether the bank code (case-insensitive) is a
+// known Indonesian bank or e-wallet code. It accepts both maps so payout
+// targets keep working after the bank/e-wallet split.
+func IsValidBankIDR(bankCode string) bool {
+	return IsBankOrEWalletIDR(bankCode)
+}
+
+// IsEWalletIDR reports whether the code (case-insensitive) is a known
+// Indonesian e-wallet.
+func IsEWalletIDR(code string) bool {
+	_, ok := EWalletsIDR[strings.ToUpper(code)]
+	return ok
+}
+
+// IsBankOrEWalletIDR reports whether the code (case-insensitive) is a
+// known Indonesian bank or e-wallet.
+func IsBankOrEWalletIDR(code string) bool {
+	return IsValidBank(code, CurrencyIDR) || IsEWalletIDR(code)
+}
+
+// IsValidBankMYR reports whether the bank code (case-insensitive) is a
+// known Malaysian bank code.
+func IsValidBankMYR(bankCode string) bool {
+	return IsValidBank(bankCode, CurrencyMYR)
+}
+
+// IsValidBankTHB reports whether the bank code (case-insensitive) is a
+// known Thai bank code.
+func IsValidBankTHB(bankCode string) bool {
+	return IsValidBank(bankCode, CurrencyTHB)
+}
+
+// IsValidBank reports whether the bank code (case-insensitive) is known
+// for the given currency.
+func IsValidBank(bankCode string, currency Currency) bool {
+	_, ok := banksByCurrency[currency][strings.ToUpper(bankCode)]
+	return ok
+}
+
+// GetAllBanks returns the bank maps of every bank-transfer currency. The
+// returned maps are copies and safe to modify.
+func GetAllBanks() map[Currency]map[string]string {
+	all := make(map[Currency]map[string]string, len(banksByCurrency))
+	for currency, banks := range banksByCurrency {
+		copied := make(map[string]string, len(banks))
+		for code, name := range banks {
+			copied[code] = name
+		}
+		all[currency] = copied
+	}
+	return all
+}
+
+// BankMatch is one result of SearchBankByName.
+type BankMatch struct {
+	Code     string
+	Name     string
+	Currency Currency
+}
+
+// SearchBankByName finds banks whose display name contains the query,
+// case-insensitively, across all currencies. An empty query matches
+// nothing.
+func SearchBankByName(query string) []BankMatch {
+	if query == "" {
+		return nil
+	}
+	query = strings.ToLower(query)
+	var matches []BankMatch
+	for currency, banks := range banksByCurrency {
+		for code, name := range banks {
+			if strings.Contains(strings.ToLower(name), query) {
+				matches = append(matches, BankMatch{Code: code, Name: name, Currency: currency})
+			}
+		}
+	}
+	return matches
+}
+
+// GetBankCurrencies returns the currencies that support the given bank
+// code (case-insensitive). Codes such as CIMB appear in more than one
+// currency.
+func GetBankCurrencies(bankCode string) []Currency {
+	code := strings.ToUpper(bankCode)
+	var currencies []Currency
+	for _, currency := range []Currency{CurrencyIDR, CurrencyTHB, CurrencyMYR} {
+		if _, ok := banksByCurrency[currency][code]; ok {
+			currencies = append(currencies, currency)
+		}
+	}
+	return currencies
+}
+
+// PaymentMethodType distinguishes traditional banks from e-wallets.
+type PaymentMethodType string
+
+const (
+	// PaymentMethodBank is a traditional bank transfer target.
+	PaymentMethodBank PaymentMethodType = "bank"
+	// PaymentMethodEWallet is an e-wallet target.
+	PaymentMethodEWallet PaymentMethodType = "ewallet"
+)
+
+// PaymentMethodInfo describes one payment method for UI listings.
+type PaymentMethodInfo struct {
+	Name string
+	Type PaymentMethodType
+}
+
+// GetAllPaymentMethodsIDR returns every Indonesian payment method —
+// banks and e-wallets — keyed by code. The returned map is a copy and
+// safe to modify.
+func GetAllPaymentMethodsIDR() map[string]PaymentMethodInfo {
+	methods := make(map[string]PaymentMethodInfo, len(BanksIDR)+len(EWalletsIDR))
+	for code, name := range BanksIDR {
+		methods[code] = PaymentMethodInfo{Name: name, Type: PaymentMethodBank}
+	}
+	for code, name := range EWalletsIDR {
+		methods[code] = PaymentMethodInfo{Name: name, Type: PaymentMethodEWallet}
+	}
+	return methods
+}
+
+// GetPaymentMethodType returns whether the code (case-insensitive) is a
+// bank or an e-wallet for the given currency. Only IDR has e-wallets;
+// other currencies report every known code as a bank.
+func GetPaymentMethodType(code string, currency Currency) (PaymentMethodType, bool) {
+	if currency == CurrencyIDR && IsEWalletIDR(code) {
+		return PaymentMethodEWallet, true
+	}
+	if IsValidBank(code, currency) {
+		return PaymentMethodBank, true
+	}
+	return "", false
+}
@@ -0,0 +1,115 @@
+package constants
+
+import "testing"
+
+func TestGetAllBanks(t *testing.T) {
+	all := GetAllBanks()
+	for _, currency := range []Currency{CurrencyIDR, CurrencyTHB, CurrencyMYR} {
+		if len(all[currency]) == 0 {
+			t.Errorf("GetAllBanks()[%s] is empty", currency)
+		}
+	}
+	all[CurrencyIDR]["FAKE"] = "Fake Bank"
+	if _, ok := BanksIDR["FAKE"]; ok {
+		t.Error("GetAllBanks returned the live BanksIDR map")
+	}
+}
+
+func TestSearchBankByName(t *testing.T) {
+	if got := SearchBankByName(""); got != nil {
+		t.Errorf("SearchBankByName(\"\") = %v, want nil", got)
+	}
+	matches := SearchBankByName("cimb")
+	currencies := map[Currency]bool{}
+	for _, m := range matches {
+		currencies[m.Currency] = true
+	}
+	if !currencies[CurrencyIDR] || !currencies[CurrencyMYR] || !currencies[CurrencyTHB] {
+		t.Errorf("SearchBankByName(cimb) currencies = %v, want IDR, MYR and THB", currencies)
+	}
+}
+
+func TestGetBankCurrencies(t *testing.T) {
+	got := GetBankCurrencies("cimb")
+	want := map[Currency]bool{CurrencyIDR: true, CurrencyMYR: true}
+	if len(got) != 2 || !want[got[0]] || !want[got[1]] {
+		t.Errorf("GetBankCurrencies(cimb) = %v, want IDR and MYR", got)
+	}
+	if got := GetBankCurrencies("NOPE"); got != nil {
+		t.Errorf("GetBankCurrencies(NOPE) = %v, want nil", got)
+	}
+}
+
+func TestIsValidBank(t *testing.T) {
+	cases := []struct {
+		code     string
+		currency Currency
+		want     bool
+	}{
+		{"bca", CurrencyIDR, true},
+		{"BCA", CurrencyMYR, false},
+		{"KBANK", CurrencyTHB, true},
+		{"MAYBANK", CurrencyMYR, true},
+		{"BCA", CurrencyUSDT, false},
+	}
+	for _, tc := range cases {
+		if got := IsValidBank(tc.code, tc.currency); got != tc.want {
+			t.Errorf("IsValidBank(%q, %s) = %v, want %v", tc.code, tc.currency, got, tc.want)
+		}
+	}
+}
+
+func TestBankEWalletSplitIDR(t *testing.T) {
+	for _, code := range []string{"DANA", "OVO"} {
+		if _, ok := BanksIDR[code]; ok {
+			t.Errorf("BanksIDR still contains e-wallet %s", code)
+		}
+		if !IsEWalletIDR(code) {
+			t.Errorf("IsEWalletIDR(%s) = false, want true", code)
+		}
+		if !IsBankOrEWalletIDR(code) || !IsValidBankIDR(code) {
+			t.Errorf("%s not accepted by the combined lookups", code)
+		}
+	}
+	if IsEWalletIDR("BCA") {
+		t.Error("IsEWalletIDR(BCA) = true, want false")
+	}
+	if !IsBankOrEWalletIDR("bca") {
+		t.Error("IsBankOrEWalletIDR(bca) = false, want true")
+	}
+}
+
+func TestGetAllPaymentMethodsIDR(t *testing.T) {
+	methods := GetAllPaymentMethodsIDR()
+	if len(methods) != len(BanksIDR)+len(EWalletsIDR) {
+		t.Errorf("got %d methods, want %d", len(methods), len(BanksIDR)+len(EWalletsIDR))
+	}
+	if m := methods["BCA"]; m.Type != PaymentMethodBank {
+		t.Errorf("BCA type = %q, want bank", m.Type)
+	}
+	if m := methods["DANA"]; m.Type != PaymentMethodEWallet {
+		t.Errorf("DANA type = %q, want ewallet", m.Type)
+	}
+}
+
+func TestGetPaymentMethodType(t *testing.T) {
+	cases := []struct {
+		code     string
+		currency Currency
+		want     PaymentMethodType
+		ok       bool
+	}{
+		{"BCA", CurrencyIDR, PaymentMethodBank, true},
+		{"dana", CurrencyIDR, PaymentMethodEWallet, true},
+		{"KBANK", CurrencyTHB, PaymentMethodBank, true},
+		{"DANA", CurrencyTHB, "", false},
+		{"NOTABANK", CurrencyIDR, "", false},
+	}
+	for _, tc := range cases {
+		got, ok := GetPaymentMethodType(tc.code, tc.currency)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("GetPaymentMethodType(%s, %s) = (%q, %v), want (%q, %v)",
+				tc.code, tc.currency, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package constants
+
+// ChannelIDR identifies a specific Indonesian payment channel. When no
+// channel is supplied GSPAY2 presents its hosted channel selection page.
+type ChannelIDR string
+
+const (
+	// ChannelQRIS creates a QRIS payment and returns a QR string.
+	ChannelQRIS ChannelIDR = "QRIS"
+	// ChannelDANA creates a DANA e-wallet payment.
+	ChannelDANA ChannelIDR = "DANA"
+	// ChannelOVO creates an OVO e-wallet payment.
+	ChannelOVO ChannelIDR = "OVO"
+	// ChannelVA creates a bank virtual account payment.
+	ChannelVA ChannelIDR = "VA"
+)
+
+// ChannelsIDR maps Indonesian channel codes to their display names.
+var ChannelsIDR = map[ChannelIDR]string{
+	ChannelQRIS: "QRIS",
+	ChannelDANA: "DANA",
+	ChannelOVO:  "OVO",
+	ChannelVA:   "Virtual Account",
+}
+
+// IsValidChannelIDR reports whether the channel is a known Indonesian
+// payment channel.
+func IsValidChannelIDR(channel ChannelIDR) bool {
+	_, ok := ChannelsIDR[channel]
+	return ok
+}
+
+// ChannelMYR identifies a specific Malaysian payment channel.
+type ChannelMYR string
+
+const (
+	// ChannelFPX creates an FPX online banking payment.
+	ChannelFPX ChannelMYR = "FPX"
+	// ChannelTNG creates a Touch 'n Go eWallet payment.
+	ChannelTNG ChannelMYR = "TNG"
+	// ChannelGrabPay creates a GrabPay e-wallet payment.
+	ChannelGrabPay ChannelMYR = "GRABPAY"
+	// ChannelBoost creates a Boost e-wallet payment.
+	ChannelBoost ChannelMYR = "BOOST"
+)
+
+// ChannelsMYR maps Malaysian channel codes to their display names.
+var ChannelsMYR = map[ChannelMYR]string{
+	ChannelFPX:     "FPX",
+	ChannelTNG:     "Touch 'n Go eWallet",
+	ChannelGrabPay: "GrabPay",
+	ChannelBoost:   "Boost",
+}
+
+// IsValidChannelMYR reports whether the channel is a known Malaysian
+// payment channel.
+func IsValidChannelMYR(channel ChannelMYR) bool {
+	_, ok := ChannelsMYR[channel]
+	return ok
+}
+
+// ChannelTHB identifies a specific Thai payment channel.
+type ChannelTHB string
+
+const (
+	// ChannelPromptPay creates a PromptPay QR payment.
+	ChannelPromptPay ChannelTHB = "PROMPTPAY"
+	// ChannelTrueMoney creates a TrueMoney Wallet payment.
+	ChannelTrueMoney ChannelTHB = "TRUEMONEY"
+)
+
+// ChannelsTHB maps Thai channel codes to their display names.
+var ChannelsTHB = map[ChannelTHB]string{
+	ChannelPromptPay: "PromptPay",
+	ChannelTrueMoney: "TrueMoney Wallet",
+}
+
+// IsValidChannelTHB reports whether the channel is a known Thai payment
+// channel.
+func IsValidChannelTHB(channel ChannelTHB) bool {
+	_, ok := ChannelsTHB[channel]
+	return ok
+}
@@ -0,0 +1,36 @@
+package constants
+
+import "testing"
+
+func TestIsValidChannelIDR(t *testing.T) {
+	for _, channel := range []ChannelIDR{ChannelQRIS, ChannelDANA, ChannelOVO, ChannelVA} {
+		if !IsValidChannelIDR(channel) {
+			t.Errorf("IsValidChannelIDR(%s) = false, want true", channel)
+		}
+	}
+	if IsValidChannelIDR("GOPAY") {
+		t.Error("IsValidChannelIDR(GOPAY) = true, want false")
+	}
+}
+
+func TestIsValidChannelMYR(t *testing.T) {
+	for _, channel := range []ChannelMYR{ChannelFPX, ChannelTNG, ChannelGrabPay, ChannelBoost} {
+		if !IsValidChannelMYR(channel) {
+			t.Errorf("IsValidChannelMYR(%s) = false, want true", channel)
+		}
+	}
+	if IsValidChannelMYR("DUITNOW") {
+		t.Error("IsValidChannelMYR(DUITNOW) = true, want false")
+	}
+}
+
+func TestIsValidChannelTHB(t *testing.T) {
+	for _, channel := range []ChannelTHB{ChannelPromptPay, ChannelTrueMoney} {
+		if !IsValidChannelTHB(channel) {
+			t.Errorf("IsValidChannelTHB(%s) = false, want true", channel)
+		}
+	}
+	if IsValidChannelTHB("LINEPAY") {
+		t.Error("IsValidChannelTHB(LINEPAY) = true, want false")
+	}
+}
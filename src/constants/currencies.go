@@ -0,0 +1,39 @@
+package constants
+
+// Currency identifies a settlement currency supported by GSPAY2.
+type Currency string
+
+const (
+	// CurrencyIDR is the Indonesian Rupiah.
+	CurrencyIDR Currency = "IDR"
+	// CurrencyTHB is the Thai Baht.
+	CurrencyTHB Currency = "THB"
+	// CurrencyMYR is the Malaysian Ringgit.
+	CurrencyMYR Currency = "MYR"
+	// CurrencyUSDT is Tether settled over TRC20.
+	CurrencyUSDT Currency = "USDT"
+)
+
+// USDTNetwork identifies the blockchain network a USDT payment settles
+// on.
+type USDTNetwork string
+
+const (
+	// NetworkTRC20 settles USDT over TRON and is the default.
+	NetworkTRC20 USDTNetwork = "trc20"
+	// NetworkERC20 settles USDT over Ethereum.
+	NetworkERC20 USDTNetwork = "erc20"
+)
+
+// USDTNetworks maps the supported USDT networks to their display names.
+var USDTNetworks = map[USDTNetwork]string{
+	NetworkTRC20: "TRON TRC20",
+	NetworkERC20: "Ethereum ERC20",
+}
+
+// IsValidUSDTNetwork reports whether the network is a supported USDT
+// settlement network.
+func IsValidUSDTNetwork(network USDTNetwork) bool {
+	_, ok := USDTNetworks[network]
+	return ok
+}
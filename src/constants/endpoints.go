@@ -0,0 +1,112 @@
+package constants
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/internal/sanitize"
+)
+
+// Endpoint is a GSPAY2 URL pattern with a single %s placeholder for the
+// operator auth key. Use Format to fill the placeholder and Sanitize when
+// the path is destined for log output.
+type Endpoint string
+
+// Endpoint names used as keys into the endpoint pattern table.
+const (
+	EndpointIDRCreate           = "idr_payment_create"
+	EndpointIDRStatus           = "idr_payment_status"
+	EndpointIDRStatusByID       = "idr_payment_status_by_id"
+	EndpointIDRPaymentHistory   = "idr_payment_history"
+	EndpointIDRRefund           = "idr_payment_refund"
+	EndpointUSDTCreate          = "usdt_payment_create"
+	EndpointUSDTCreateByNetwork = "usdt_payment_create_by_network"
+	EndpointUSDTStatus          = "usdt_payment_status"
+	EndpointMYRCreate           = "myr_payment_create"
+	EndpointMYRStatus           = "myr_payment_status"
+
+	EndpointPayoutIDRCreate     = "idr_payout_create"
+	EndpointPayoutIDRStatus     = "idr_payout_status"
+	EndpointPayoutIDRStatusByID = "idr_payout_status_by_id"
+	EndpointPayoutIDRHistory    = "idr_payout_history"
+	EndpointPayoutMYRCreate     = "myr_payout_create"
+	EndpointPayoutMYRStatus     = "myr_payout_status"
+	EndpointPayoutTHBCreate     = "thb_payout_create"
+	EndpointPayoutTHBStatus     = "thb_payout_status"
+
+	EndpointBalance = "balance"
+)
+
+var endpoints = map[string]Endpoint{
+	EndpointIDRCreate:         "/v2/integrations/operators/%s/idr/payment",
+	EndpointIDRStatus:         "/v2/integrations/operators/%s/idr/payment/status",
+	EndpointIDRStatusByID:     "/v2/integrations/operators/%s/idr/payment/status",
+	EndpointIDRPaymentHistory: "/v2/integrations/operators/%s/idr/payment/history",
+	EndpointIDRRefund:         "/v2/integrations/operators/%s/idr/payment/refund",
+	EndpointUSDTCreate:        "/v2/integrations/operators/%s/cryptocurrency/trc20/usdt",
+	// The second verb is escaped so Format fills the auth key and the
+	// service fills the network.
+	EndpointUSDTCreateByNetwork: "/v2/integrations/operators/%s/cryptocurrency/%%s/usdt",
+	EndpointUSDTStatus:          "/v2/integrations/operators/%s/cryptocurrency/usdt/status",
+	EndpointMYRCreate:           "/v2/integrations/operators/%s/myr/payment",
+	EndpointMYRStatus:           "/v2/integrations/operators/%s/myr/payment/status",
+
+	EndpointPayoutIDRCreate:     "/v2/integrations/operators/%s/idr/payout",
+	EndpointPayoutIDRStatus:     "/v2/integrations/operators/%s/idr/payout/status",
+	EndpointPayoutIDRStatusByID: "/v2/integrations/operators/%s/idr/payout/status",
+	EndpointPayoutIDRHistory:    "/v2/integrations/operators/%s/idr/payout/history",
+	EndpointPayoutMYRCreate:     "/v2/integrations/operators/%s/myr/payout",
+	EndpointPayoutMYRStatus:     "/v2/integrations/operators/%s/myr/payout/status",
+	EndpointPayoutTHBCreate:     "/v2/integrations/operators/%s/thb/payout",
+	EndpointPayoutTHBStatus:     "/v2/integrations/operators/%s/thb/payout/status",
+
+	EndpointBalance: "/v2/integrations/operators/%s/balance",
+}
+
+// GetEndpoint returns the URL pattern for the named endpoint, or an empty
+// pattern for unknown names. The pattern must be formatted with the
+// operator auth key before use.
+func GetEndpoint(name string) Endpoint {
+	return endpoints[name]
+}
+
+// AllEndpoints returns the endpoint patterns keyed by name. The returned
+// map is a copy and safe to modify.
+func AllEndpoints() map[string]Endpoint {
+	all := make(map[string]Endpoint, len(endpoints))
+	for name, pattern := range endpoints {
+		all[name] = pattern
+	}
+	return all
+}
+
+// Format fills the pattern's auth key placeholder and returns the request
+// path. A result containing a %! directive means the pattern and argument
+// count disagree, which is reported as errors.ErrEndpointFormatFailure.
+func (e Endpoint) Format(authKey string) (string, error) {
+	formatted := fmt.Sprintf(string(e), authKey)
+	if strings.Contains(formatted, "%!") {
+		return "", fmt.Errorf("%w: pattern %q", errors.ErrEndpointFormatFailure, string(e))
+	}
+	return formatted, nil
+}
+
+// PathType classifies the endpoint as "payment", "payout" or "balance" for
+// use in metrics labels.
+func (e Endpoint) PathType() string {
+	switch {
+	case strings.Contains(string(e), "/payout"):
+		return "payout"
+	case strings.Contains(string(e), "/balance"):
+		return "balance"
+	default:
+		return "payment"
+	}
+}
+
+// Sanitize formats the pattern with the auth key and redacts the key from
+// the result, producing a path safe for log output.
+func (e Endpoint) Sanitize(authKey string) string {
+	return sanitize.Endpoint(fmt.Sprintf(string(e), authKey))
+}
@@ -0,0 +1,84 @@
+package constants
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestAllEndpointsFormat(t *testing.T) {
+	const authKey = "op-auth-key"
+	for name, pattern := range AllEndpoints() {
+		path, err := pattern.Format(authKey)
+		if err != nil {
+			t.Errorf("%s: Format: %v", name, err)
+			continue
+		}
+		if !strings.Contains(path, "/operators/"+authKey+"/") {
+			t.Errorf("%s: path %q does not embed the auth key", name, path)
+		}
+		if strings.Contains(path, "%!") {
+			t.Errorf("%s: path %q contains a format directive", name, path)
+		}
+	}
+}
+
+func TestEndpointFormatEmptyAuthKey(t *testing.T) {
+	path, err := GetEndpoint(EndpointIDRCreate).Format("")
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if path != "/v2/integrations/operators//idr/payment" {
+		t.Errorf("path = %q, want the pattern with an empty key", path)
+	}
+}
+
+func TestEndpointFormatFailure(t *testing.T) {
+	bad := Endpoint("/v2/operators/%s/%s/payment")
+	if _, err := bad.Format("key"); !errors.Is(err, errors.ErrEndpointFormatFailure) {
+		t.Errorf("Format error = %v, want ErrEndpointFormatFailure", err)
+	}
+}
+
+func TestEndpointPathType(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{EndpointIDRCreate, "payment"},
+		{EndpointUSDTStatus, "payment"},
+		{EndpointMYRCreate, "payment"},
+		{EndpointPayoutIDRCreate, "payout"},
+		{EndpointPayoutTHBStatus, "payout"},
+		{EndpointBalance, "balance"},
+	}
+	for _, tt := range tests {
+		if got := GetEndpoint(tt.name).PathType(); got != tt.want {
+			t.Errorf("PathType(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	for name, pattern := range AllEndpoints() {
+		switch pattern.PathType() {
+		case "payment", "payout", "balance":
+		default:
+			t.Errorf("%s: PathType = %q, want payment, payout or balance", name, pattern.PathType())
+		}
+	}
+}
+
+func TestEndpointSanitize(t *testing.T) {
+	const authKey = "op-auth-key"
+	for name, pattern := range AllEndpoints() {
+		sanitized := pattern.Sanitize(authKey)
+		if strings.Contains(sanitized, authKey) {
+			t.Errorf("%s: sanitized path %q leaks the auth key", name, sanitized)
+		}
+		if !strings.Contains(sanitized, "/operators/****/") && name != EndpointBalance {
+			t.Errorf("%s: sanitized path %q is not redacted", name, sanitized)
+		}
+	}
+	if got := GetEndpoint(EndpointBalance).Sanitize(authKey); got != "/v2/integrations/operators/****/balance" {
+		t.Errorf("Sanitize(balance) = %q", got)
+	}
+}
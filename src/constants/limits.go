@@ -0,0 +1,29 @@
+package constants
+
+// Validation limits enforced before a request is sent to GSPAY2.
+const (
+	// MinTransactionIDLength is the minimum accepted transaction ID length.
+	MinTransactionIDLength = 5
+	// MaxTransactionIDLength is the maximum accepted transaction ID length.
+	MaxTransactionIDLength = 20
+
+	// MinAmountIDR is the minimum payment or payout amount in Rupiah.
+	MinAmountIDR int64 = 10000
+	// MinAmountMYR is the minimum payment amount in sen (MYR cents).
+	MinAmountMYR int64 = 100
+	// MinAmountTHB is the minimum payment amount in satang (THB cents).
+	MinAmountTHB int64 = 1000
+	// MinAmountUSDT is the minimum USDT payment amount.
+	MinAmountUSDT float64 = 1
+
+	// MaxIdempotencyKeyLength is the maximum accepted idempotency key length.
+	MaxIdempotencyKeyLength = 64
+
+	// MaxHistoryPageSize is the maximum page size accepted by the history
+	// endpoints.
+	MaxHistoryPageSize = 100
+
+	// MaxBulkConcurrency caps how many requests a bulk operation may run
+	// in parallel, regardless of what the caller asks for.
+	MaxBulkConcurrency = 10
+)
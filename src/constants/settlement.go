@@ -0,0 +1,53 @@
+package constants
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultSettlementTime is the settlement estimate used for bank codes
+// without an entry in BankSettlementTimes.
+const DefaultSettlementTime = 24 * time.Hour
+
+// BankSettlementTimes maps Indonesian bank and e-wallet codes to how long
+// a payout typically takes to settle. These are estimates for display
+// purposes only — actual settlement depends on the receiving bank's
+// processing windows and may be slower outside business hours.
+var BankSettlementTimes = map[string]time.Duration{
+	// Major banks on the real-time BI-FAST rails.
+	"BCA":     2 * time.Hour,
+	"BRI":     2 * time.Hour,
+	"BNI":     2 * time.Hour,
+	"MANDIRI": 2 * time.Hour,
+	// Mid-size banks typically settling via interbank transfer.
+	"CIMB":     4 * time.Hour,
+	"PERMATA":  4 * time.Hour,
+	"DANAMON":  4 * time.Hour,
+	"BSI":      4 * time.Hour,
+	"BTN":      6 * time.Hour,
+	"PANIN":    6 * time.Hour,
+	"OCBC":     6 * time.Hour,
+	"MAYBANK":  6 * time.Hour,
+	"MEGA":     6 * time.Hour,
+	"SINARMAS": 6 * time.Hour,
+	// E-wallet top-ups land near-instantly.
+	"DANA": 30 * time.Minute,
+	"OVO":  30 * time.Minute,
+}
+
+// SettlementTime returns the estimated settlement time for the bank or
+// e-wallet code (case-insensitive), or DefaultSettlementTime for codes
+// without an estimate.
+func SettlementTime(bankCode string) time.Duration {
+	if d, ok := BankSettlementTimes[strings.ToUpper(bankCode)]; ok {
+		return d
+	}
+	return DefaultSettlementTime
+}
+
+// IsInstantSettlement reports whether payouts to the code (case-insensitive)
+// settle near-instantly, which holds for the Indonesian e-wallets such as
+// DANA and OVO.
+func IsInstantSettlement(bankCode string) bool {
+	return IsEWalletIDR(bankCode)
+}
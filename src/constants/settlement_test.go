@@ -0,0 +1,49 @@
+package constants
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSettlementTime(t *testing.T) {
+	tests := []struct {
+		bankCode string
+		want     time.Duration
+	}{
+		{"BCA", 2 * time.Hour},
+		{"MANDIRI", 2 * time.Hour},
+		{"CIMB", 4 * time.Hour},
+		{"BTN", 6 * time.Hour},
+		{"DANA", 30 * time.Minute},
+		{"OVO", 30 * time.Minute},
+		{"bca", 2 * time.Hour},
+		{"UNKNOWN", DefaultSettlementTime},
+		{"", DefaultSettlementTime},
+	}
+	for _, tt := range tests {
+		if got := SettlementTime(tt.bankCode); got != tt.want {
+			t.Errorf("SettlementTime(%q) = %v, want %v", tt.bankCode, got, tt.want)
+		}
+	}
+}
+
+func TestBankSettlementTimesCoverKnownCodes(t *testing.T) {
+	for code := range BankSettlementTimes {
+		if !IsBankOrEWalletIDR(code) {
+			t.Errorf("BankSettlementTimes contains unknown code %q", code)
+		}
+	}
+}
+
+func TestIsInstantSettlement(t *testing.T) {
+	for _, code := range []string{"DANA", "OVO", "dana"} {
+		if !IsInstantSettlement(code) {
+			t.Errorf("IsInstantSettlement(%q) = false, want true", code)
+		}
+	}
+	for _, code := range []string{"BCA", "UNKNOWN", ""} {
+		if IsInstantSettlement(code) {
+			t.Errorf("IsInstantSettlement(%q) = true, want false", code)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Package constants holds the values shared between the GSPAY2 API and the
+// SDK: payment statuses, bank and channel codes, endpoint patterns and
+// validation limits.
+package constants
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// PaymentStatus is the numeric status GSPAY2 reports for payments and
+// payouts.
+type PaymentStatus int
+
+const (
+	// StatusPending means the payment has not been completed yet, or has
+	// expired without being paid.
+	StatusPending PaymentStatus = 0
+	// StatusSuccess means the payment completed successfully.
+	StatusSuccess PaymentStatus = 1
+	// StatusFailed means the payment was attempted but failed.
+	StatusFailed PaymentStatus = 2
+	// StatusProcessing means a payout has been accepted but not yet
+	// completed. Payments do not report this state.
+	StatusProcessing PaymentStatus = 3
+	// StatusTimeout means the payment timed out on the GSPAY2 side.
+	StatusTimeout PaymentStatus = 4
+)
+
+// String returns the English name of the status.
+func (s PaymentStatus) String() string {
+	switch s {
+	case StatusPending:
+		return "Pending/Expired"
+	case StatusSuccess:
+		return "Success"
+	case StatusFailed:
+		return "Failed"
+	case StatusProcessing:
+		return "Processing"
+	case StatusTimeout:
+		return "Timeout"
+	}
+	return "Unknown(" + strconv.Itoa(int(s)) + ")"
+}
+
+// IsPending reports whether the status is StatusPending.
+func (s PaymentStatus) IsPending() bool { return s == StatusPending }
+
+// IsSuccess reports whether the status is StatusSuccess.
+func (s PaymentStatus) IsSuccess() bool { return s == StatusSuccess }
+
+// IsFailed reports whether the status is StatusFailed.
+func (s PaymentStatus) IsFailed() bool { return s == StatusFailed }
+
+// IsProcessing reports whether the status is StatusProcessing.
+func (s PaymentStatus) IsProcessing() bool { return s == StatusProcessing }
+
+// IsTimeout reports whether the status is StatusTimeout.
+func (s PaymentStatus) IsTimeout() bool { return s == StatusTimeout }
+
+// statusMessageKeys maps each status to its translation key.
+var statusMessageKeys = map[PaymentStatus]i18n.MessageKey{
+	StatusPending:    i18n.MsgStatusPending,
+	StatusSuccess:    i18n.MsgStatusSuccess,
+	StatusFailed:     i18n.MsgStatusFailed,
+	StatusProcessing: i18n.MsgStatusProcessing,
+	StatusTimeout:    i18n.MsgStatusTimeout,
+}
+
+// Localized returns the status name translated into lang. Values outside
+// the defined statuses translate as the unknown-status message.
+func (s PaymentStatus) Localized(lang i18n.Language) string {
+	key, ok := statusMessageKeys[s]
+	if !ok {
+		key = i18n.MsgStatusUnknown
+	}
+	return i18n.Get(lang, key)
+}
+
+// MarshalText encodes the status as its English String form, for callers
+// marshaling into text-based formats. JSON keeps the integer wire format
+// because MarshalJSON takes precedence.
+func (s PaymentStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText accepts the English String form, the lowercase wire names
+// and bare integers.
+func (s *PaymentStatus) UnmarshalText(text []byte) error {
+	name := strings.TrimSpace(string(text))
+	if parsed, ok := ParsePaymentStatusString(name); ok {
+		*s = parsed
+		return nil
+	}
+	if strings.EqualFold(name, StatusPending.String()) {
+		*s = StatusPending
+		return nil
+	}
+	if v, err := strconv.Atoi(name); err == nil {
+		*s = PaymentStatus(v)
+		return nil
+	}
+	return fmt.Errorf("constants: unknown payment status %q", name)
+}
+
+// paymentStatusNames maps the statuses to the string form some GSPAY2 API
+// variants use in place of the integer.
+var paymentStatusNames = map[PaymentStatus]string{
+	StatusPending:    "pending",
+	StatusSuccess:    "success",
+	StatusFailed:     "failed",
+	StatusProcessing: "processing",
+	StatusTimeout:    "timeout",
+}
+
+// MarshalJSON encodes the status as its integer value, matching the main
+// GSPAY2 wire format.
+func (s PaymentStatus) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(s))), nil
+}
+
+// UnmarshalJSON accepts both the integer form (0–4) and the string form
+// ("pending", "success", "failed", "processing", "timeout") used by some
+// GSPAY2 API variants. Unknown integers are kept as-is; unknown strings are an error.
+func (s *PaymentStatus) UnmarshalJSON(data []byte) error {
+	raw := strings.TrimSpace(string(data))
+	if len(raw) > 0 && raw[0] == '"' {
+		name, err := strconv.Unquote(raw)
+		if err != nil {
+			return fmt.Errorf("constants: invalid payment status %s", raw)
+		}
+		parsed, ok := ParsePaymentStatusString(name)
+		if !ok {
+			return fmt.Errorf("constants: unknown payment status %q", name)
+		}
+		*s = parsed
+		return nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return fmt.Errorf("constants: invalid payment status %s", raw)
+	}
+	*s = PaymentStatus(v)
+	return nil
+}
+
+// ParsePaymentStatusString converts the string status form into a
+// PaymentStatus. The second return value reports whether the name is one
+// of the defined statuses.
+func ParsePaymentStatusString(name string) (PaymentStatus, bool) {
+	for status, n := range paymentStatusNames {
+		if n == strings.ToLower(name) {
+			return status, true
+		}
+	}
+	return StatusPending, false
+}
+
+// ParsePaymentStatus converts a raw integer from the API into a
+// PaymentStatus. The second return value reports whether the value is one
+// of the defined statuses.
+func ParsePaymentStatus(v int) (PaymentStatus, bool) {
+	switch PaymentStatus(v) {
+	case StatusPending, StatusSuccess, StatusFailed, StatusProcessing, StatusTimeout:
+		return PaymentStatus(v), true
+	}
+	return PaymentStatus(v), false
+}
@@ -0,0 +1,134 @@
+package constants
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestPaymentStatusJSONRoundTrip(t *testing.T) {
+	for _, status := range []PaymentStatus{StatusPending, StatusSuccess, StatusFailed, StatusProcessing, StatusTimeout} {
+		data, err := json.Marshal(status)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", status, err)
+		}
+		var got PaymentStatus
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got != status {
+			t.Errorf("round trip %v = %v", status, got)
+		}
+	}
+}
+
+func TestPaymentStatusUnmarshalString(t *testing.T) {
+	cases := map[string]PaymentStatus{
+		`"pending"`:    StatusPending,
+		`"success"`:    StatusSuccess,
+		`"failed"`:     StatusFailed,
+		`"processing"`: StatusProcessing,
+		`"timeout"`:    StatusTimeout,
+	}
+	for data, want := range cases {
+		var got PaymentStatus
+		if err := json.Unmarshal([]byte(data), &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got != want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", data, got, want)
+		}
+	}
+}
+
+func TestPaymentStatusUnmarshalUnknownInt(t *testing.T) {
+	var got PaymentStatus
+	if err := json.Unmarshal([]byte("7"), &got); err != nil {
+		t.Fatalf("Unmarshal(7): %v", err)
+	}
+	if got != PaymentStatus(7) {
+		t.Errorf("Unmarshal(7) = %v, want 7", got)
+	}
+}
+
+func TestPaymentStatusUnmarshalUnknownString(t *testing.T) {
+	var got PaymentStatus
+	if err := json.Unmarshal([]byte(`"refunded"`), &got); err == nil {
+		t.Fatal("Unmarshal(refunded): want error, got nil")
+	}
+}
+
+func TestParsePaymentStatusString(t *testing.T) {
+	if s, ok := ParsePaymentStatusString("Success"); !ok || s != StatusSuccess {
+		t.Errorf("ParsePaymentStatusString(Success) = %v, %v", s, ok)
+	}
+	if _, ok := ParsePaymentStatusString("refunded"); ok {
+		t.Error("ParsePaymentStatusString(refunded) = ok, want false")
+	}
+}
+
+func TestStatusProcessing(t *testing.T) {
+	if got := StatusProcessing.String(); got != "Processing" {
+		t.Errorf("StatusProcessing.String() = %q, want Processing", got)
+	}
+	if !StatusProcessing.IsProcessing() {
+		t.Error("StatusProcessing.IsProcessing() = false, want true")
+	}
+	if StatusSuccess.IsProcessing() {
+		t.Error("StatusSuccess.IsProcessing() = true, want false")
+	}
+	if s, ok := ParsePaymentStatus(3); !ok || s != StatusProcessing {
+		t.Errorf("ParsePaymentStatus(3) = %v, %v, want StatusProcessing, true", s, ok)
+	}
+}
+
+func TestPaymentStatusLocalized(t *testing.T) {
+	cases := []struct {
+		status PaymentStatus
+		en, id string
+	}{
+		{StatusPending, "Pending/Expired", "Menunggu/Kedaluwarsa"},
+		{StatusSuccess, "Success", "Berhasil"},
+		{StatusFailed, "Failed", "Gagal"},
+		{StatusProcessing, "Processing", "Sedang diproses"},
+		{StatusTimeout, "Timeout", "Waktu habis"},
+	}
+	for _, tc := range cases {
+		if got := tc.status.Localized(i18n.English); got != tc.en {
+			t.Errorf("%v.Localized(English) = %q, want %q", tc.status, got, tc.en)
+		}
+		if got := tc.status.Localized(i18n.Indonesian); got != tc.id {
+			t.Errorf("%v.Localized(Indonesian) = %q, want %q", tc.status, got, tc.id)
+		}
+	}
+}
+
+func TestPaymentStatusLocalizedUnknown(t *testing.T) {
+	if got := PaymentStatus(99).Localized(i18n.English); got != "Unknown" {
+		t.Errorf("Localized = %q, want Unknown", got)
+	}
+}
+
+func TestPaymentStatusTextRoundTrip(t *testing.T) {
+	for _, status := range []PaymentStatus{StatusPending, StatusSuccess, StatusFailed, StatusProcessing, StatusTimeout} {
+		text, err := status.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v): %v", status, err)
+		}
+		var got PaymentStatus
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", text, err)
+		}
+		if got != status {
+			t.Errorf("round trip %v = %v", status, got)
+		}
+	}
+}
+
+func TestPaymentStatusUnmarshalTextRejectsUnknown(t *testing.T) {
+	var got PaymentStatus
+	if err := got.UnmarshalText([]byte("paid")); err == nil {
+		t.Error("UnmarshalText accepted an unknown status name")
+	}
+}
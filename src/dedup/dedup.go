@@ -0,0 +1,98 @@
+// Package dedup provides callback deduplication so resent GSPAY2
+// callbacks are processed at most once.
+package dedup
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Deduplicator remembers which callback transaction IDs have already been
+// processed. Implementations must be safe for concurrent use.
+type Deduplicator interface {
+	// IsProcessed reports whether the transaction ID was already marked.
+	IsProcessed(transactionID string) bool
+	// MarkProcessed records the transaction ID as processed.
+	MarkProcessed(transactionID string)
+}
+
+// LRUDeduplicator is an in-memory Deduplicator bounded by capacity and an
+// optional per-entry TTL. The least recently marked entry is evicted when
+// the capacity is exceeded.
+type LRUDeduplicator struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+// lruEntry is the value stored in the eviction list.
+type lruEntry struct {
+	id       string
+	markedAt time.Time
+}
+
+// NewLRUDeduplicator returns an LRUDeduplicator holding at most capacity
+// entries. Entries older than ttl are treated as unseen; a zero ttl keeps
+// entries until they are evicted by capacity. Capacities below one fall
+// back to a single entry.
+func NewLRUDeduplicator(capacity int, ttl time.Duration) *LRUDeduplicator {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUDeduplicator{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// IsProcessed reports whether the transaction ID was marked and has not
+// expired.
+func (d *LRUDeduplicator) IsProcessed(transactionID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	el, ok := d.entries[transactionID]
+	if !ok {
+		return false
+	}
+	entry := el.Value.(*lruEntry)
+	if d.ttl > 0 && d.now().Sub(entry.markedAt) > d.ttl {
+		d.order.Remove(el)
+		delete(d.entries, transactionID)
+		return false
+	}
+	return true
+}
+
+// MarkProcessed records the transaction ID, refreshing its position and
+// timestamp if already present, and evicts the least recently marked
+// entry when the capacity is exceeded.
+func (d *LRUDeduplicator) MarkProcessed(transactionID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if el, ok := d.entries[transactionID]; ok {
+		el.Value.(*lruEntry).markedAt = d.now()
+		d.order.MoveToFront(el)
+		return
+	}
+	d.entries[transactionID] = d.order.PushFront(&lruEntry{id: transactionID, markedAt: d.now()})
+	for len(d.entries) > d.capacity {
+		oldest := d.order.Back()
+		d.order.Remove(oldest)
+		delete(d.entries, oldest.Value.(*lruEntry).id)
+	}
+}
+
+// Len returns the number of remembered entries, including expired ones
+// that have not been touched since expiring.
+func (d *LRUDeduplicator) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.entries)
+}
@@ -0,0 +1,74 @@
+package dedup
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLRUDeduplicatorMarksAndReports(t *testing.T) {
+	d := NewLRUDeduplicator(10, 0)
+	if d.IsProcessed("TXN1") {
+		t.Error("IsProcessed(TXN1) = true before marking")
+	}
+	d.MarkProcessed("TXN1")
+	if !d.IsProcessed("TXN1") {
+		t.Error("IsProcessed(TXN1) = false after marking")
+	}
+}
+
+func TestLRUDeduplicatorEvictsOldest(t *testing.T) {
+	d := NewLRUDeduplicator(2, 0)
+	d.MarkProcessed("TXN1")
+	d.MarkProcessed("TXN2")
+	d.MarkProcessed("TXN1") // refresh TXN1 so TXN2 is now oldest
+	d.MarkProcessed("TXN3")
+	if d.IsProcessed("TXN2") {
+		t.Error("IsProcessed(TXN2) = true, want evicted")
+	}
+	if !d.IsProcessed("TXN1") || !d.IsProcessed("TXN3") {
+		t.Error("refreshed and newest entries evicted, want kept")
+	}
+	if got := d.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+}
+
+func TestLRUDeduplicatorTTLExpiry(t *testing.T) {
+	d := NewLRUDeduplicator(10, time.Minute)
+	current := time.Now()
+	d.now = func() time.Time { return current }
+	d.MarkProcessed("TXN1")
+	if !d.IsProcessed("TXN1") {
+		t.Fatal("IsProcessed(TXN1) = false before expiry")
+	}
+	current = current.Add(2 * time.Minute)
+	if d.IsProcessed("TXN1") {
+		t.Error("IsProcessed(TXN1) = true after TTL, want expired")
+	}
+	// Expired entries are removed, so re-marking works as first sight.
+	if got := d.Len(); got != 0 {
+		t.Errorf("Len() = %d after expiry check, want 0", got)
+	}
+}
+
+func TestLRUDeduplicatorConcurrent(t *testing.T) {
+	d := NewLRUDeduplicator(100, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				id := fmt.Sprintf("TXN%d", j%150)
+				d.MarkProcessed(id)
+				d.IsProcessed(id)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if got := d.Len(); got != 100 {
+		t.Errorf("Len() = %d, want capacity 100", got)
+	}
+}
@@ -0,0 +1,89 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// APIError is returned when GSPAY2 responds with a non-success status. Code
+// holds the HTTP status code, Message the error text from the response body
+// (when present) and Endpoint the sanitized endpoint that was called.
+//
+// APIError implements Is by HTTP status code: errors.Is(err, target)
+// matches any *APIError in err's chain whose Code equals the target's,
+// so errors.Is(err, ErrAPIUnauthorized) matches every 401 regardless of
+// message or endpoint.
+type APIError struct {
+	Code     int
+	Message  string
+	Endpoint string
+	// RequestID correlates the failed call with application and GSPAY2
+	// logs. It is empty unless a request ID generator is configured or the
+	// response carried an X-Request-ID header.
+	RequestID string
+	// Lang controls the language of the Error output. The zero value
+	// falls back to English.
+	Lang i18n.Language
+}
+
+// WithRequestID returns the error with the correlation ID set, for code
+// that attaches the ID after constructing the error.
+func (e *APIError) WithRequestID(id string) *APIError {
+	e.RequestID = id
+	return e
+}
+
+// Error implements the error interface with a localized message. The
+// request ID is appended when set so it reaches support tickets.
+func (e *APIError) Error() string {
+	var msg string
+	if e.Endpoint != "" {
+		msg = fmt.Sprintf(i18n.Get(e.Lang, i18n.MsgAPIErrorFormat), e.Code, e.Endpoint, e.Message)
+	} else {
+		msg = fmt.Sprintf(i18n.Get(e.Lang, i18n.MsgAPIErrorFormatNoURL), e.Code, e.Message)
+	}
+	if e.RequestID != "" {
+		msg += " (request ID " + e.RequestID + ")"
+	}
+	return msg
+}
+
+// Is reports whether target is also an *APIError with the same Code, so
+// errors.Is can match API errors by HTTP status.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == e.Code
+}
+
+// NewAPIErrorMatcher returns an *APIError carrying only a status code,
+// intended as the target of errors.Is.
+func NewAPIErrorMatcher(code int) *APIError {
+	return &APIError{Code: code}
+}
+
+// Semantic matchers for the most common API failure statuses, for use
+// with errors.Is.
+var (
+	ErrAPIBadRequest   = NewAPIErrorMatcher(400)
+	ErrAPIUnauthorized = NewAPIErrorMatcher(401)
+	ErrAPIForbidden    = NewAPIErrorMatcher(403)
+	ErrAPINotFound     = NewAPIErrorMatcher(404)
+	ErrAPIServerError  = NewAPIErrorMatcher(500)
+)
+
+// IsAPIError reports whether err's chain contains an *APIError.
+func IsAPIError(err error) bool {
+	var ae *APIError
+	return stderrors.As(err, &ae)
+}
+
+// GetAPIError returns the *APIError in err's chain, or nil.
+func GetAPIError(err error) *APIError {
+	var ae *APIError
+	if stderrors.As(err, &ae) {
+		return ae
+	}
+	return nil
+}
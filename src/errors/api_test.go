@@ -0,0 +1,94 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestAPIErrorEnglish(t *testing.T) {
+	err := &APIError{Code: 500, Message: "internal", Endpoint: "/v2/ping", Lang: i18n.English}
+	want := "gspay: API error 500 on /v2/ping: internal"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	noURL := &APIError{Code: 500, Message: "internal"}
+	if got, want := noURL.Error(), "gspay: API error 500: internal"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIErrorIndonesian(t *testing.T) {
+	err := &APIError{Code: 403, Message: "dilarang", Endpoint: "/v2/ping", Lang: i18n.Indonesian}
+	want := "gspay: kesalahan API 403 pada /v2/ping: dilarang"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIErrorZeroLangDefaultsToEnglish(t *testing.T) {
+	err := &APIError{Code: 404, Message: "not found", Endpoint: "/v2/ping"}
+	want := "gspay: API error 404 on /v2/ping: not found"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestGetAPIError(t *testing.T) {
+	base := &APIError{Code: 502, Message: "bad gateway", Lang: i18n.Indonesian}
+	wrapped := fmt.Errorf("call failed: %w", base)
+	if !IsAPIError(wrapped) {
+		t.Fatal("IsAPIError = false, want true")
+	}
+	got := GetAPIError(wrapped)
+	if got == nil || got.Code != 502 || got.Lang != i18n.Indonesian {
+		t.Errorf("GetAPIError = %+v, want original *APIError", got)
+	}
+	if GetAPIError(fmt.Errorf("plain")) != nil {
+		t.Error("GetAPIError(plain) != nil")
+	}
+}
+
+func TestAPIErrorWithRequestID(t *testing.T) {
+	err := (&APIError{Code: 502, Message: "bad gateway"}).WithRequestID("req-42")
+	if err.RequestID != "req-42" {
+		t.Errorf("RequestID = %q, want req-42", err.RequestID)
+	}
+	if !strings.Contains(err.Error(), "req-42") {
+		t.Errorf("Error() = %q, want request ID included", err.Error())
+	}
+
+	plain := &APIError{Code: 502, Message: "bad gateway"}
+	if strings.Contains(plain.Error(), "request ID") {
+		t.Errorf("Error() = %q, want no request ID suffix when unset", plain.Error())
+	}
+}
+
+func TestAPIErrorIsMatchesByCode(t *testing.T) {
+	err := fmt.Errorf("call failed: %w", &APIError{Code: 401, Message: "unauthorized", Endpoint: "/v2/ping"})
+	if !Is(err, ErrAPIUnauthorized) {
+		t.Error("Is(401 error, ErrAPIUnauthorized) = false, want true")
+	}
+	if Is(err, ErrAPIForbidden) {
+		t.Error("Is(401 error, ErrAPIForbidden) = true, want false")
+	}
+	if Is(err, ErrAPIServerError) {
+		t.Error("Is(401 error, ErrAPIServerError) = true, want false")
+	}
+	if Is(fmt.Errorf("plain"), ErrAPIUnauthorized) {
+		t.Error("Is(plain, ErrAPIUnauthorized) = true, want false")
+	}
+}
+
+func TestNewAPIErrorMatcher(t *testing.T) {
+	err := &APIError{Code: 418, Message: "teapot"}
+	if !Is(err, NewAPIErrorMatcher(418)) {
+		t.Error("Is(418 error, matcher 418) = false, want true")
+	}
+	if Is(err, NewAPIErrorMatcher(500)) {
+		t.Error("Is(418 error, matcher 500) = true, want false")
+	}
+}
@@ -0,0 +1,43 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// CircuitOpenError is returned while the client's circuit breaker is open
+// and requests are rejected without contacting GSPAY2. It wraps
+// ErrCircuitOpen, so errors.Is(err, ErrCircuitOpen) matches.
+type CircuitOpenError struct {
+	// RetryAfter suggests how long to wait before the next attempt: the
+	// remaining time until the breaker admits a probe request.
+	RetryAfter time.Duration
+	// Lang controls the language of the Error output.
+	Lang i18n.Language
+}
+
+// NewCircuitOpenError builds a CircuitOpenError with the given retry
+// suggestion.
+func NewCircuitOpenError(lang i18n.Language, retryAfter time.Duration) *CircuitOpenError {
+	return &CircuitOpenError{RetryAfter: retryAfter, Lang: lang}
+}
+
+// Error implements the error interface with a localized message.
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf(i18n.Get(e.Lang, i18n.MsgCircuitOpenFormat), e.RetryAfter)
+}
+
+// Unwrap returns ErrCircuitOpen for errors.Is matching.
+func (e *CircuitOpenError) Unwrap() error { return ErrCircuitOpen }
+
+// GetCircuitOpenError returns the *CircuitOpenError in err's chain, or nil.
+func GetCircuitOpenError(err error) *CircuitOpenError {
+	var ce *CircuitOpenError
+	if stderrors.As(err, &ce) {
+		return ce
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package errors defines the error types produced by the SDK: sentinel
+// errors for errors.Is matching, ValidationError for rejected input and
+// APIError for non-success responses from GSPAY2.
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// New wraps a sentinel error with its localized message. Optional context
+// values are appended to the message. A trailing context value that is
+// itself an error is wrapped with %w, so errors.Is and errors.As see
+// through to the underlying cause — for example a *url.Error from the
+// transport. Other context values are formatted with %v. The multiple %w
+// verbs require Go 1.20, which the module's go directive guarantees.
+func New(lang i18n.Language, sentinel error, context ...any) error {
+	msg := i18n.Get(lang, sentinelMessages[sentinel])
+	if len(context) == 0 {
+		return fmt.Errorf("%w: %s", sentinel, msg)
+	}
+	if cause, ok := context[len(context)-1].(error); ok {
+		if rest := context[:len(context)-1]; len(rest) > 0 {
+			return fmt.Errorf("%w: %s: %v: %w", sentinel, msg, fmt.Sprint(rest...), cause)
+		}
+		return fmt.Errorf("%w: %s: %w", sentinel, msg, cause)
+	}
+	return fmt.Errorf("%w: %s: %v", sentinel, msg, fmt.Sprint(context...))
+}
+
+// Is reports whether any error in err's chain matches target. It is a
+// convenience re-export so callers do not need to import both this package
+// and the standard library errors package.
+func Is(err, target error) bool { return stderrors.Is(err, target) }
+
+// As finds the first error in err's chain that matches target.
+func As(err error, target any) bool { return stderrors.As(err, target) }
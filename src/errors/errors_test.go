@@ -0,0 +1,51 @@
+package errors
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestNewWrapsErrorCause(t *testing.T) {
+	cause := stderrors.New("connection refused")
+	err := New(i18n.English, ErrRequestFailed, cause)
+	if !Is(err, ErrRequestFailed) {
+		t.Errorf("Is(err, ErrRequestFailed) = false, want true")
+	}
+	if !Is(err, cause) {
+		t.Errorf("Is(err, cause) = false, want the cause wrapped with %%w")
+	}
+	if !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("err = %q, want the cause in the message", err)
+	}
+}
+
+func TestNewWrapsErrorCauseAfterContext(t *testing.T) {
+	cause := stderrors.New("connection refused")
+	err := New(i18n.English, ErrRequestFailed, "attempt 3", cause)
+	if !Is(err, ErrRequestFailed) || !Is(err, cause) {
+		t.Errorf("err = %v, want both the sentinel and the cause matchable", err)
+	}
+	if !strings.Contains(err.Error(), "attempt 3") {
+		t.Errorf("err = %q, want the string context in the message", err)
+	}
+}
+
+func TestNewStringContextNotWrapped(t *testing.T) {
+	err := New(i18n.English, ErrInvalidAmount, "TXN12345")
+	if !Is(err, ErrInvalidAmount) {
+		t.Errorf("Is(err, ErrInvalidAmount) = false, want true")
+	}
+	if !strings.Contains(err.Error(), "TXN12345") {
+		t.Errorf("err = %q, want the context in the message", err)
+	}
+}
+
+func TestNewWithoutContext(t *testing.T) {
+	err := New(i18n.English, ErrTimeout)
+	if !Is(err, ErrTimeout) {
+		t.Errorf("Is(err, ErrTimeout) = false, want true")
+	}
+}
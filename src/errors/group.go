@@ -0,0 +1,100 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// groupErrorPreview is the number of individual messages included in the
+// group's own Error output before the rest are elided.
+const groupErrorPreview = 3
+
+// ErrorGroup collects multiple errors from batch operations or multi-field
+// validation into a single error value.
+type ErrorGroup struct {
+	// Lang controls the language of the Error output.
+	Lang i18n.Language
+
+	errs []error
+}
+
+// NewErrorGroup returns an ErrorGroup seeded with the given errors. Nil
+// entries are skipped.
+func NewErrorGroup(errs ...error) *ErrorGroup {
+	g := &ErrorGroup{Lang: i18n.English}
+	for _, err := range errs {
+		g.Add(err)
+	}
+	return g
+}
+
+// Add appends a non-nil error to the group.
+func (g *ErrorGroup) Add(err error) {
+	if err != nil {
+		g.errs = append(g.errs, err)
+	}
+}
+
+// HasErrors reports whether the group contains at least one error.
+func (g *ErrorGroup) HasErrors() bool { return len(g.errs) > 0 }
+
+// Errors returns a copy of the collected errors.
+func (g *ErrorGroup) Errors() []error {
+	errs := make([]error, len(g.errs))
+	copy(errs, g.errs)
+	return errs
+}
+
+// Error implements the error interface with a localized count followed by
+// the first few collected messages.
+func (g *ErrorGroup) Error() string {
+	preview := g.errs
+	if len(preview) > groupErrorPreview {
+		preview = preview[:groupErrorPreview]
+	}
+	msgs := make([]string, len(preview))
+	for i, err := range preview {
+		msgs[i] = err.Error()
+	}
+	joined := strings.Join(msgs, "; ")
+	if elided := len(g.errs) - len(preview); elided > 0 {
+		joined += "; " + fmt.Sprintf(i18n.Get(g.Lang, i18n.MsgErrorGroupElided), elided)
+	}
+	return fmt.Sprintf(i18n.Get(g.Lang, i18n.MsgErrorGroupFormat), len(g.errs), joined)
+}
+
+// Unwrap returns the collected errors so errors.Is and errors.As traverse
+// all of them.
+func (g *ErrorGroup) Unwrap() []error { return g.errs }
+
+// Err collapses the group for returning from a validation pass: nil when
+// empty, the sole error when the group holds exactly one, and the group
+// itself otherwise.
+func (g *ErrorGroup) Err() error {
+	switch len(g.errs) {
+	case 0:
+		return nil
+	case 1:
+		return g.errs[0]
+	default:
+		return g
+	}
+}
+
+// IsErrorGroup reports whether err's chain contains an *ErrorGroup.
+func IsErrorGroup(err error) bool {
+	var eg *ErrorGroup
+	return stderrors.As(err, &eg)
+}
+
+// GetErrorGroup returns the *ErrorGroup in err's chain, or nil.
+func GetErrorGroup(err error) *ErrorGroup {
+	var eg *ErrorGroup
+	if stderrors.As(err, &eg) {
+		return eg
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestErrorGroupIsTraversal(t *testing.T) {
+	g := NewErrorGroup(
+		fmt.Errorf("request 0: %w", ErrInvalidAmount),
+		fmt.Errorf("request 1: %w", ErrInvalidBankCode),
+	)
+	if !Is(g, ErrInvalidAmount) {
+		t.Error("errors.Is(group, ErrInvalidAmount) = false, want true")
+	}
+	if !Is(g, ErrInvalidBankCode) {
+		t.Error("errors.Is(group, ErrInvalidBankCode) = false, want true")
+	}
+	if Is(g, ErrSignatureMismatch) {
+		t.Error("errors.Is(group, ErrSignatureMismatch) = true, want false")
+	}
+}
+
+func TestErrorGroupAsExtraction(t *testing.T) {
+	ve := NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmount)
+	g := NewErrorGroup(ErrRequestFailed, ve)
+	var got *ValidationError
+	if !As(g, &got) {
+		t.Fatal("errors.As(group, *ValidationError) = false, want true")
+	}
+	if got.Field != "amount" {
+		t.Errorf("extracted Field = %q, want amount", got.Field)
+	}
+}
+
+func TestErrorGroupErrorMessage(t *testing.T) {
+	g := NewErrorGroup()
+	for i := 0; i < 5; i++ {
+		g.Add(fmt.Errorf("problem %d", i))
+	}
+	msg := g.Error()
+	if !strings.HasPrefix(msg, "gspay: 5 errors occurred:") {
+		t.Errorf("Error() = %q, want count prefix", msg)
+	}
+	if !strings.Contains(msg, "and 2 more") {
+		t.Errorf("Error() = %q, want elided suffix", msg)
+	}
+	if strings.Contains(msg, "problem 4") {
+		t.Errorf("Error() = %q, should elide later messages", msg)
+	}
+}
+
+func TestErrorGroupEmptyAndHelpers(t *testing.T) {
+	g := NewErrorGroup(nil, nil)
+	if g.HasErrors() {
+		t.Error("HasErrors() = true for empty group")
+	}
+	if len(g.Errors()) != 0 {
+		t.Errorf("Errors() = %v, want empty", g.Errors())
+	}
+
+	wrapped := fmt.Errorf("outer: %w", NewErrorGroup(ErrRequestFailed))
+	if !IsErrorGroup(wrapped) {
+		t.Error("IsErrorGroup(wrapped) = false, want true")
+	}
+	if got := GetErrorGroup(wrapped); got == nil || len(got.Errors()) != 1 {
+		t.Errorf("GetErrorGroup(wrapped) = %v, want group with one error", got)
+	}
+	if IsErrorGroup(ErrRequestFailed) {
+		t.Error("IsErrorGroup(plain sentinel) = true, want false")
+	}
+}
@@ -0,0 +1,145 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// MultiValidationError collects the ValidationErrors from a multi-field
+// validation pass, indexed by field for constant-time lookup. Unlike
+// ErrorGroup it only holds validation errors, so callers can inspect each
+// rejected field without type assertions.
+type MultiValidationError struct {
+	// Lang controls the language of the Error output.
+	Lang i18n.Language
+
+	errs    []*ValidationError
+	byField map[string]*ValidationError
+}
+
+// NewMultiValidationError returns an empty MultiValidationError.
+func NewMultiValidationError(lang i18n.Language) *MultiValidationError {
+	return &MultiValidationError{Lang: lang, byField: make(map[string]*ValidationError)}
+}
+
+// Add appends a non-nil validation error. The first error for a field
+// wins; later errors for the same field are kept in the iteration order
+// but do not replace the field lookup entry.
+func (m *MultiValidationError) Add(ve *ValidationError) {
+	if ve == nil {
+		return
+	}
+	m.errs = append(m.errs, ve)
+	if _, ok := m.byField[ve.Field]; !ok {
+		m.byField[ve.Field] = ve
+	}
+}
+
+// FieldError returns the first validation error recorded for the field,
+// or nil. The lookup is constant time.
+func (m *MultiValidationError) FieldError(field string) *ValidationError {
+	return m.byField[field]
+}
+
+// HasField reports whether the field was rejected.
+func (m *MultiValidationError) HasField(field string) bool {
+	_, ok := m.byField[field]
+	return ok
+}
+
+// Fields returns the rejected field names in the order the errors were
+// added, without duplicates.
+func (m *MultiValidationError) Fields() []string {
+	fields := make([]string, 0, len(m.byField))
+	seen := make(map[string]bool, len(m.byField))
+	for _, ve := range m.errs {
+		if !seen[ve.Field] {
+			seen[ve.Field] = true
+			fields = append(fields, ve.Field)
+		}
+	}
+	return fields
+}
+
+// Errors returns a copy of the collected validation errors.
+func (m *MultiValidationError) Errors() []*ValidationError {
+	errs := make([]*ValidationError, len(m.errs))
+	copy(errs, m.errs)
+	return errs
+}
+
+// Merge returns a new MultiValidationError holding the errors of both
+// receivers, keeping m's order first and skipping other's entries for
+// fields m already rejected. Either side may be nil.
+func (m *MultiValidationError) Merge(other *MultiValidationError) *MultiValidationError {
+	lang := i18n.English
+	if m != nil {
+		lang = m.Lang
+	} else if other != nil {
+		lang = other.Lang
+	}
+	merged := NewMultiValidationError(lang)
+	if m != nil {
+		for _, ve := range m.errs {
+			merged.Add(ve)
+		}
+	}
+	if other != nil {
+		for _, ve := range other.errs {
+			if !merged.HasField(ve.Field) {
+				merged.Add(ve)
+			}
+		}
+	}
+	return merged
+}
+
+// Error implements the error interface with a localized count followed by
+// the first few collected messages, matching ErrorGroup's format.
+func (m *MultiValidationError) Error() string {
+	preview := m.errs
+	if len(preview) > groupErrorPreview {
+		preview = preview[:groupErrorPreview]
+	}
+	msgs := make([]string, len(preview))
+	for i, ve := range preview {
+		msgs[i] = ve.Error()
+	}
+	joined := strings.Join(msgs, "; ")
+	if elided := len(m.errs) - len(preview); elided > 0 {
+		joined += "; " + fmt.Sprintf(i18n.Get(m.Lang, i18n.MsgErrorGroupElided), elided)
+	}
+	return fmt.Sprintf(i18n.Get(m.Lang, i18n.MsgErrorGroupFormat), len(m.errs), joined)
+}
+
+// Unwrap returns the collected errors so errors.Is and errors.As traverse
+// all of them.
+func (m *MultiValidationError) Unwrap() []error {
+	errs := make([]error, len(m.errs))
+	for i, ve := range m.errs {
+		errs[i] = ve
+	}
+	return errs
+}
+
+// Err collapses the collection for returning from a validation pass: nil
+// when no field was rejected, the receiver otherwise.
+func (m *MultiValidationError) Err() *MultiValidationError {
+	if m == nil || len(m.errs) == 0 {
+		return nil
+	}
+	return m
+}
+
+// GetMultiValidationError returns the *MultiValidationError in err's
+// chain, or nil.
+func GetMultiValidationError(err error) *MultiValidationError {
+	var mve *MultiValidationError
+	if stderrors.As(err, &mve) {
+		return mve
+	}
+	return nil
+}
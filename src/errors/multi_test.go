@@ -0,0 +1,101 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestMultiValidationErrorFieldLookup(t *testing.T) {
+	m := NewMultiValidationError(i18n.English)
+	// Large enough that a linear scan would dominate if lookups were O(n);
+	// the map-backed lookup keeps this trivial.
+	for i := 0; i < 10000; i++ {
+		m.Add(NewValidationError(i18n.English, fmt.Sprintf("field_%d", i), i18n.MsgRequiredField))
+	}
+	ve := m.FieldError("field_9999")
+	if ve == nil || ve.Field != "field_9999" {
+		t.Errorf("FieldError(field_9999) = %v, want matching error", ve)
+	}
+	if m.FieldError("missing") != nil {
+		t.Error("FieldError(missing) != nil")
+	}
+	if !m.HasField("field_0") || m.HasField("missing") {
+		t.Error("HasField gave wrong answers")
+	}
+}
+
+func TestMultiValidationErrorFieldsDeterministic(t *testing.T) {
+	build := func() *MultiValidationError {
+		m := NewMultiValidationError(i18n.English)
+		m.Add(NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmount, ErrInvalidAmount))
+		m.Add(NewValidationError(i18n.English, "bank_code", i18n.MsgInvalidBankCode, ErrInvalidBankCode))
+		m.Add(NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmountFormat, ErrInvalidAmount))
+		m.Add(NewValidationError(i18n.English, "username", i18n.MsgRequiredField))
+		return m
+	}
+	want := []string{"amount", "bank_code", "username"}
+	for run := 0; run < 5; run++ {
+		got := build().Fields()
+		if len(got) != len(want) {
+			t.Fatalf("Fields() = %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Fields() = %v, want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestMultiValidationErrorMerge(t *testing.T) {
+	a := NewMultiValidationError(i18n.English)
+	a.Add(NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmount, ErrInvalidAmount))
+	a.Add(NewValidationError(i18n.English, "username", i18n.MsgRequiredField))
+	b := NewMultiValidationError(i18n.English)
+	b.Add(NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmountFormat, ErrInvalidAmount))
+	b.Add(NewValidationError(i18n.English, "bank_code", i18n.MsgInvalidBankCode, ErrInvalidBankCode))
+
+	merged := a.Merge(b)
+	want := []string{"amount", "username", "bank_code"}
+	got := merged.Fields()
+	if len(got) != len(want) {
+		t.Fatalf("merged.Fields() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("merged.Fields() = %v, want %v", got, want)
+		}
+	}
+	// a's entry wins for the duplicated field.
+	if ve := merged.FieldError("amount"); ve == nil || ve.Key != i18n.MsgInvalidAmount {
+		t.Errorf("merged amount error = %v, want a's MsgInvalidAmount entry", ve)
+	}
+	// Originals are untouched.
+	if len(a.Errors()) != 2 || len(b.Errors()) != 2 {
+		t.Error("Merge mutated its inputs")
+	}
+	if got := (*MultiValidationError)(nil).Merge(b); len(got.Errors()) != 2 {
+		t.Errorf("nil.Merge(b) has %d errors, want 2", len(got.Errors()))
+	}
+}
+
+func TestMultiValidationErrorChains(t *testing.T) {
+	m := NewMultiValidationError(i18n.English)
+	m.Add(NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmount, ErrInvalidAmount))
+	var err error = m.Err()
+	if !stderrors.Is(err, ErrInvalidAmount) {
+		t.Error("errors.Is(m, ErrInvalidAmount) = false, want true")
+	}
+	if GetValidationError(err) == nil {
+		t.Error("GetValidationError(m) = nil, want inner error")
+	}
+	if GetMultiValidationError(err) != m {
+		t.Error("GetMultiValidationError did not return the collection")
+	}
+	if empty := NewMultiValidationError(i18n.English).Err(); empty != nil {
+		t.Errorf("empty.Err() = %v, want nil", empty)
+	}
+}
@@ -0,0 +1,45 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// RateLimitError is returned when GSPAY2 answers with HTTP 429. It wraps
+// ErrRateLimited, so errors.Is(err, ErrRateLimited) matches, and carries
+// the server's Retry-After suggestion for application-level backoff.
+type RateLimitError struct {
+	// RetryAfter is the wait the server suggested via the Retry-After
+	// header; zero when the header was absent or unparseable.
+	RetryAfter time.Duration
+	// Endpoint is the (sanitized) endpoint that was rate limited.
+	Endpoint string
+	// Lang controls the language of the Error output.
+	Lang i18n.Language
+}
+
+// NewRateLimitError builds a RateLimitError for the given endpoint and
+// retry suggestion.
+func NewRateLimitError(lang i18n.Language, retryAfter time.Duration, endpoint string) *RateLimitError {
+	return &RateLimitError{RetryAfter: retryAfter, Endpoint: endpoint, Lang: lang}
+}
+
+// Error implements the error interface with a localized message.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf(i18n.Get(e.Lang, i18n.MsgRateLimitedFormat), e.Endpoint, e.RetryAfter)
+}
+
+// Unwrap returns ErrRateLimited for errors.Is matching.
+func (e *RateLimitError) Unwrap() error { return ErrRateLimited }
+
+// GetRateLimitError returns the *RateLimitError in err's chain, or nil.
+func GetRateLimitError(err error) *RateLimitError {
+	var re *RateLimitError
+	if stderrors.As(err, &re) {
+		return re
+	}
+	return nil
+}
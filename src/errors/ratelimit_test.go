@@ -0,0 +1,35 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestRateLimitErrorMatchesSentinel(t *testing.T) {
+	err := NewRateLimitError(i18n.English, 30*time.Second, "/v2/integrations/operators/****/idr/payment")
+	if !Is(err, ErrRateLimited) {
+		t.Error("Is(err, ErrRateLimited) = false, want true")
+	}
+	wrapped := fmt.Errorf("creating payment: %w", err)
+	if !Is(wrapped, ErrRateLimited) {
+		t.Error("Is(wrapped, ErrRateLimited) = false, want true")
+	}
+}
+
+func TestGetRateLimitError(t *testing.T) {
+	err := NewRateLimitError(i18n.English, 30*time.Second, "/probe")
+	wrapped := fmt.Errorf("creating payment: %w", err)
+	re := GetRateLimitError(wrapped)
+	if re == nil {
+		t.Fatal("GetRateLimitError = nil, want the error from the chain")
+	}
+	if re.RetryAfter != 30*time.Second || re.Endpoint != "/probe" {
+		t.Errorf("RetryAfter/Endpoint = %v/%q, want 30s and /probe", re.RetryAfter, re.Endpoint)
+	}
+	if GetRateLimitError(ErrRateLimited) != nil {
+		t.Error("GetRateLimitError(ErrRateLimited) != nil, want nil for the bare sentinel")
+	}
+}
@@ -0,0 +1,71 @@
+package errors
+
+import (
+	stderrors "errors"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// Sentinel errors returned by the SDK. Use errors.Is to match them; the
+// localized message is attached by New when the error is produced.
+var (
+	ErrInvalidTransactionID  = stderrors.New("gspay: invalid transaction id")
+	ErrInvalidAmount         = stderrors.New("gspay: invalid amount")
+	ErrInvalidBankCode       = stderrors.New("gspay: invalid bank code")
+	ErrInvalidChannel        = stderrors.New("gspay: invalid channel")
+	ErrMissingCallbackField  = stderrors.New("gspay: missing callback field")
+	ErrSignatureMismatch     = stderrors.New("gspay: signature mismatch")
+	ErrDuplicateCallback     = stderrors.New("gspay: duplicate callback")
+	ErrInvalidJSON           = stderrors.New("gspay: invalid json")
+	ErrRequestFailed         = stderrors.New("gspay: request failed")
+	ErrUnauthenticated       = stderrors.New("gspay: unauthenticated")
+	ErrRateLimited           = stderrors.New("gspay: rate limited")
+	ErrUnauthorizedIP        = stderrors.New("gspay: unauthorized callback ip")
+	ErrCircuitOpen           = stderrors.New("gspay: circuit breaker open")
+	ErrInvalidHTTPMethod     = stderrors.New("gspay: invalid http method")
+	ErrInvalidContentType    = stderrors.New("gspay: invalid content type")
+	ErrCallbackTooLarge      = stderrors.New("gspay: callback body too large")
+	ErrResponseTooLarge      = stderrors.New("gspay: response body too large")
+	ErrTimeout               = stderrors.New("gspay: request timed out")
+	ErrConnectionRefused     = stderrors.New("gspay: connection refused")
+	ErrInvalidConfiguration  = stderrors.New("gspay: invalid configuration")
+	ErrInsecureURL           = stderrors.New("gspay: insecure base url")
+	ErrReservedHeader        = stderrors.New("gspay: reserved header override")
+	ErrInsufficientBalance   = stderrors.New("gspay: insufficient balance")
+	ErrEmptyQRCode           = stderrors.New("gspay: empty qr code")
+	ErrClientDraining        = stderrors.New("gspay: client draining")
+	ErrInconsistentCallback  = stderrors.New("gspay: inconsistent callback")
+	ErrEndpointFormatFailure = stderrors.New("gspay: endpoint format failure")
+)
+
+// sentinelMessages maps each sentinel to the message key used to localize
+// the error text produced by New.
+var sentinelMessages = map[error]i18n.MessageKey{
+	ErrInvalidTransactionID:  i18n.MsgInvalidTransactionID,
+	ErrInvalidAmount:         i18n.MsgInvalidAmount,
+	ErrInvalidBankCode:       i18n.MsgInvalidBankCode,
+	ErrInvalidChannel:        i18n.MsgInvalidChannel,
+	ErrMissingCallbackField:  i18n.MsgMissingCallbackField,
+	ErrSignatureMismatch:     i18n.MsgSignatureMismatch,
+	ErrDuplicateCallback:     i18n.MsgDuplicateCallback,
+	ErrInvalidJSON:           i18n.MsgInvalidJSON,
+	ErrRequestFailed:         i18n.MsgRequestFailed,
+	ErrUnauthenticated:       i18n.MsgUnauthenticated,
+	ErrRateLimited:           i18n.MsgRateLimited,
+	ErrUnauthorizedIP:        i18n.MsgUnauthorizedIP,
+	ErrCircuitOpen:           i18n.MsgCircuitOpen,
+	ErrInvalidHTTPMethod:     i18n.MsgInvalidHTTPMethod,
+	ErrInvalidContentType:    i18n.MsgInvalidContentType,
+	ErrCallbackTooLarge:      i18n.MsgCallbackTooLarge,
+	ErrResponseTooLarge:      i18n.MsgResponseTooLarge,
+	ErrTimeout:               i18n.MsgTimeout,
+	ErrConnectionRefused:     i18n.MsgConnectionRefused,
+	ErrInvalidConfiguration:  i18n.MsgInvalidConfiguration,
+	ErrInsecureURL:           i18n.MsgInsecureURL,
+	ErrReservedHeader:        i18n.MsgReservedHeader,
+	ErrInsufficientBalance:   i18n.MsgInsufficientBalance,
+	ErrEmptyQRCode:           i18n.MsgEmptyQRCode,
+	ErrClientDraining:        i18n.MsgClientDraining,
+	ErrInconsistentCallback:  i18n.MsgInconsistentCallback,
+	ErrEndpointFormatFailure: i18n.MsgEndpointFormatFailure,
+}
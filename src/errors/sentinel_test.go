@@ -0,0 +1,79 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// sentinels lists every sentinel declared in sentinel.go. Keeping the list
+// here guards against a sentinel being redeclared elsewhere: two
+// declarations would have distinct identities and the duplicate-check below
+// would fail.
+var sentinels = []error{
+	ErrInvalidTransactionID,
+	ErrInvalidAmount,
+	ErrInvalidBankCode,
+	ErrInvalidChannel,
+	ErrMissingCallbackField,
+	ErrSignatureMismatch,
+	ErrDuplicateCallback,
+	ErrInvalidJSON,
+	ErrRequestFailed,
+	ErrUnauthenticated,
+	ErrRateLimited,
+	ErrUnauthorizedIP,
+	ErrCircuitOpen,
+	ErrInvalidHTTPMethod,
+	ErrInvalidContentType,
+	ErrCallbackTooLarge,
+	ErrResponseTooLarge,
+	ErrTimeout,
+	ErrConnectionRefused,
+	ErrInvalidConfiguration,
+	ErrInsecureURL,
+	ErrReservedHeader,
+	ErrInsufficientBalance,
+	ErrEmptyQRCode,
+	ErrClientDraining,
+	ErrInconsistentCallback,
+	ErrEndpointFormatFailure,
+}
+
+func TestSentinelsAreDistinct(t *testing.T) {
+	seen := map[error]bool{}
+	for _, s := range sentinels {
+		if seen[s] {
+			t.Errorf("sentinel %v declared more than once", s)
+		}
+		seen[s] = true
+	}
+	if len(seen) != len(sentinelMessages) {
+		t.Errorf("sentinel count = %d, sentinelMessages has %d entries", len(seen), len(sentinelMessages))
+	}
+}
+
+func TestSentinelsHaveTranslations(t *testing.T) {
+	for _, s := range sentinels {
+		key, ok := sentinelMessages[s]
+		if !ok {
+			t.Errorf("sentinel %v has no message key", s)
+			continue
+		}
+		for _, lang := range []i18n.Language{i18n.English, i18n.Indonesian} {
+			if got := i18n.Get(lang, key); got == "" || got == string(key) {
+				t.Errorf("key %q has no %s translation (got %q)", key, lang, got)
+			}
+		}
+	}
+}
+
+func TestNewLocalizesTimeoutSentinels(t *testing.T) {
+	err := New(i18n.English, ErrTimeout)
+	if !Is(err, ErrTimeout) {
+		t.Errorf("errors.Is(err, ErrTimeout) = false for %v", err)
+	}
+	if err.Error() == ErrTimeout.Error() {
+		t.Errorf("New did not attach a localized message: %q", err)
+	}
+}
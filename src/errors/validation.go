@@ -0,0 +1,55 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// ValidationError describes a request field that was rejected before any
+// network call was made.
+type ValidationError struct {
+	// Field is the snake_case name of the rejected field, e.g. "amount".
+	Field string
+	// Key is the message key describing why the field was rejected.
+	Key i18n.MessageKey
+	// Lang controls the language of the Error output.
+	Lang i18n.Language
+	// Sentinel is the sentinel error the rejection corresponds to, so
+	// errors.Is can match it. Nil when no sentinel applies.
+	Sentinel error
+}
+
+// NewValidationError builds a ValidationError for the given field. An
+// optional sentinel makes the error match it under errors.Is.
+func NewValidationError(lang i18n.Language, field string, key i18n.MessageKey, sentinel ...error) *ValidationError {
+	e := &ValidationError{Field: field, Key: key, Lang: lang}
+	if len(sentinel) > 0 {
+		e.Sentinel = sentinel[0]
+	}
+	return e
+}
+
+// Error implements the error interface with a localized message.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf(i18n.Get(e.Lang, i18n.MsgValidationFormat), e.Field, i18n.Get(e.Lang, e.Key))
+}
+
+// Unwrap exposes the sentinel for errors.Is matching.
+func (e *ValidationError) Unwrap() error { return e.Sentinel }
+
+// IsValidationError reports whether err's chain contains a *ValidationError.
+func IsValidationError(err error) bool {
+	var ve *ValidationError
+	return stderrors.As(err, &ve)
+}
+
+// GetValidationError returns the *ValidationError in err's chain, or nil.
+func GetValidationError(err error) *ValidationError {
+	var ve *ValidationError
+	if stderrors.As(err, &ve) {
+		return ve
+	}
+	return nil
+}
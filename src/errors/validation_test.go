@@ -0,0 +1,44 @@
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestValidationErrorUnwrapsSentinel(t *testing.T) {
+	ve := NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmount, ErrInvalidAmount)
+	if !stderrors.Is(ve, ErrInvalidAmount) {
+		t.Error("errors.Is(ve, ErrInvalidAmount) = false, want true")
+	}
+	if got := GetValidationError(ve); got == nil || got.Field != "amount" {
+		t.Errorf("GetValidationError = %v, want amount validation error", got)
+	}
+}
+
+func TestValidationErrorWithoutSentinel(t *testing.T) {
+	ve := NewValidationError(i18n.English, "username", i18n.MsgRequiredField)
+	if ve.Unwrap() != nil {
+		t.Errorf("Unwrap() = %v, want nil", ve.Unwrap())
+	}
+	if stderrors.Is(ve, ErrInvalidAmount) {
+		t.Error("errors.Is matched an unrelated sentinel")
+	}
+}
+
+func TestValidationErrorSentinelThroughGroup(t *testing.T) {
+	g := NewErrorGroup()
+	g.Add(NewValidationError(i18n.English, "bank_code", i18n.MsgInvalidBankCode, ErrInvalidBankCode))
+	g.Add(NewValidationError(i18n.English, "amount", i18n.MsgInvalidAmount, ErrInvalidAmount))
+	err := g.Err()
+	if !stderrors.Is(err, ErrInvalidBankCode) {
+		t.Error("errors.Is(group, ErrInvalidBankCode) = false, want true")
+	}
+	if !stderrors.Is(err, ErrInvalidAmount) {
+		t.Error("errors.Is(group, ErrInvalidAmount) = false, want true")
+	}
+	if got := GetValidationError(err); got == nil {
+		t.Error("GetValidationError(group) = nil, want first validation error")
+	}
+}
@@ -0,0 +1,112 @@
+// Package gc provides a reusable byte buffer pool used for reading HTTP
+// response bodies without allocating a fresh buffer per request.
+package gc
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// Buffer is the subset of bytes.Buffer used by the SDK. Buffers are
+// obtained from and returned to a Pool.
+type Buffer interface {
+	io.Writer
+	// ReadFrom reads from r until EOF, appending to the buffer.
+	ReadFrom(r io.Reader) (int64, error)
+	// Bytes returns the accumulated bytes. The slice is only valid until
+	// the buffer is returned to the pool.
+	Bytes() []byte
+	// String returns the accumulated bytes as a string.
+	String() string
+	// Reset truncates the buffer to zero length.
+	Reset()
+	// Grow ensures capacity for at least n more bytes.
+	Grow(n int)
+	// Len returns the number of accumulated bytes.
+	Len() int
+	// Cap returns the buffer capacity.
+	Cap() int
+}
+
+// Pool hands out Buffers for temporary use. Implementations must be safe
+// for concurrent use.
+type Pool interface {
+	// Get returns an empty Buffer.
+	Get() Buffer
+	// GetSized returns an empty Buffer with capacity for at least minCap
+	// bytes, so large reads do not grow the buffer repeatedly.
+	GetSized(minCap int) Buffer
+	// Put returns a Buffer to the pool after use.
+	Put(Buffer)
+	// Stats returns cumulative counters for observability.
+	Stats() PoolStats
+}
+
+// PoolStats are cumulative counters over the lifetime of a Pool.
+type PoolStats struct {
+	// Gets counts Get and GetSized calls.
+	Gets uint64
+	// Puts counts buffers returned with Put.
+	Puts uint64
+	// Allocs counts the Gets that could not reuse a pooled buffer.
+	Allocs uint64
+}
+
+// Default is the pool used by the SDK internals.
+var Default Pool = NewPool()
+
+// NewPool returns a Pool backed by sync.Pool.
+func NewPool() Pool {
+	p := &pool{}
+	p.inner.New = func() any {
+		atomic.AddUint64(&p.allocs, 1)
+		return &buffer{}
+	}
+	return p
+}
+
+type pool struct {
+	inner  sync.Pool
+	gets   uint64
+	puts   uint64
+	allocs uint64
+}
+
+func (p *pool) Get() Buffer {
+	atomic.AddUint64(&p.gets, 1)
+	b := p.inner.Get().(*buffer)
+	b.Reset()
+	return b
+}
+
+func (p *pool) GetSized(minCap int) Buffer {
+	b := p.Get()
+	if b.Cap() < minCap {
+		b.Grow(minCap)
+	}
+	return b
+}
+
+func (p *pool) Put(b Buffer) {
+	if b == nil {
+		return
+	}
+	if bb, ok := b.(*buffer); ok {
+		atomic.AddUint64(&p.puts, 1)
+		p.inner.Put(bb)
+	}
+}
+
+func (p *pool) Stats() PoolStats {
+	return PoolStats{
+		Gets:   atomic.LoadUint64(&p.gets),
+		Puts:   atomic.LoadUint64(&p.puts),
+		Allocs: atomic.LoadUint64(&p.allocs),
+	}
+}
+
+type buffer struct {
+	bytes.Buffer
+}
@@ -0,0 +1,81 @@
+package gc
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestGetSizedPreallocates(t *testing.T) {
+	p := NewPool()
+	b := p.GetSized(64 << 10)
+	if b.Cap() < 64<<10 {
+		t.Errorf("Cap() = %d, want at least %d", b.Cap(), 64<<10)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Len() = %d, want empty buffer", b.Len())
+	}
+	p.Put(b)
+}
+
+func TestGetSizedAvoidsGrowthAllocations(t *testing.T) {
+	p := NewPool()
+	payload := bytes.Repeat([]byte("x"), 64<<10)
+	// Warm the pool so the measured runs reuse the grown buffer.
+	p.Put(p.GetSized(len(payload)))
+	allocs := testing.AllocsPerRun(100, func() {
+		b := p.GetSized(len(payload))
+		if _, err := b.ReadFrom(bytes.NewReader(payload)); err != nil {
+			t.Fatal(err)
+		}
+		p.Put(b)
+	})
+	// One alloc per run is the bytes.Reader; the buffer itself must not
+	// grow once pre-sized.
+	if allocs > 2 {
+		t.Errorf("AllocsPerRun = %v, want at most 2", allocs)
+	}
+}
+
+func TestPoolStats(t *testing.T) {
+	p := NewPool()
+	b := p.Get()
+	p.Put(b)
+	b = p.GetSized(1024)
+	p.Put(b)
+	stats := p.Stats()
+	if stats.Gets != 2 {
+		t.Errorf("Gets = %d, want 2", stats.Gets)
+	}
+	if stats.Puts != 2 {
+		t.Errorf("Puts = %d, want 2", stats.Puts)
+	}
+	if stats.Allocs == 0 || stats.Allocs > stats.Gets {
+		t.Errorf("Allocs = %d, want in (0, %d]", stats.Allocs, stats.Gets)
+	}
+}
+
+func TestPoolStatsConcurrent(t *testing.T) {
+	p := NewPool()
+	const workers, iterations = 8, 500
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				b := p.GetSized(256)
+				b.Write([]byte("probe"))
+				p.Put(b)
+			}
+		}()
+	}
+	wg.Wait()
+	stats := p.Stats()
+	if want := uint64(workers * iterations); stats.Gets != want || stats.Puts != want {
+		t.Errorf("Gets/Puts = %d/%d, want %d each", stats.Gets, stats.Puts, want)
+	}
+	if stats.Allocs > stats.Gets {
+		t.Errorf("Allocs = %d exceeds Gets = %d", stats.Allocs, stats.Gets)
+	}
+}
@@ -0,0 +1,113 @@
+// Package amount normalizes monetary amounts into the canonical string
+// form used by the GSPAY2 signature formulas: two decimal places, no
+// thousand separators.
+package amount
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// Format parses amountStr and formats it with exactly two decimal places,
+// e.g. "50000" becomes "50000.00". Parsing and rounding use exact
+// rational arithmetic with round-half-to-even, so amounts like
+// "50000.505" round the same way regardless of their binary float64
+// representation. It returns a ValidationError for unparseable input.
+func Format(amountStr string, lang i18n.Language) (string, error) {
+	r, ok := new(big.Rat).SetString(amountStr)
+	if !ok {
+		return "", errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat)
+	}
+	return roundHalfEven(r).FloatString(2), nil
+}
+
+// roundHalfEven rounds r to two decimal places using banker's rounding:
+// exact halves go to the nearest even cent.
+func roundHalfEven(r *big.Rat) *big.Rat {
+	hundred := big.NewInt(100)
+	cents := new(big.Rat).Mul(r, new(big.Rat).SetInt(hundred))
+	rem := new(big.Int)
+	q, _ := new(big.Int).QuoRem(cents.Num(), cents.Denom(), rem)
+	twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+	if cmp := twice.Cmp(cents.Denom()); cmp > 0 || (cmp == 0 && q.Bit(0) == 1) {
+		if rem.Sign() >= 0 {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return new(big.Rat).SetFrac(q, hundred)
+}
+
+// FormatBigFloat behaves like Format but parses with 128-bit big.Float
+// precision, so amounts beyond float64's 2^53 integer range keep their
+// exact value. Prefer it wherever the formatted amount feeds a signature.
+func FormatBigFloat(amountStr string, lang i18n.Language) (string, error) {
+	f, ok := new(big.Float).SetPrec(128).SetString(amountStr)
+	if !ok {
+		return "", errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat)
+	}
+	return f.Text('f', 2), nil
+}
+
+// FormatInt formats a whole-unit amount with the two decimal places the
+// signature formulas expect, e.g. 50000 becomes "50000.00".
+func FormatInt(amount int64) string {
+	return strconv.FormatInt(amount, 10) + ".00"
+}
+
+// ParseAmount converts an amount field of any of the types the GSPAY2 API
+// uses — json.Number, string, float64, int64 or int — into a float64. It
+// returns a ValidationError on the "amount" field for any other type or
+// for unparseable input.
+func ParseAmount(v interface{}, lang i18n.Language) (float64, error) {
+	switch a := v.(type) {
+	case json.Number:
+		f, err := a.Float64()
+		if err != nil {
+			return 0, errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat)
+		}
+		return f, nil
+	case string:
+		f, err := strconv.ParseFloat(a, 64)
+		if err != nil {
+			return 0, errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat)
+		}
+		return f, nil
+	case float64:
+		return a, nil
+	case int64:
+		return float64(a), nil
+	case int:
+		return float64(a), nil
+	default:
+		return 0, errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat)
+	}
+}
+
+// ParseAmountStrict converts an amount field of any of the types accepted
+// by ParseAmount into the canonical two-decimal-place string used by the
+// signature formulas. String and json.Number inputs are parsed with
+// big.Float precision, so values beyond float64's exact integer range keep
+// their exact value.
+func ParseAmountStrict(v interface{}, lang i18n.Language) (string, error) {
+	switch a := v.(type) {
+	case json.Number:
+		return FormatBigFloat(a.String(), lang)
+	case string:
+		return FormatBigFloat(a, lang)
+	case float64:
+		return fmt.Sprintf("%.2f", a), nil
+	case int64:
+		return FormatInt(a), nil
+	case int:
+		return FormatInt(int64(a)), nil
+	default:
+		return "", errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat)
+	}
+}
@@ -0,0 +1,184 @@
+package amount
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestFormat(t *testing.T) {
+	cases := map[string]string{
+		"50000":    "50000.00",
+		"50000.5":  "50000.50",
+		"50000.55": "50000.55",
+	}
+	for in, want := range cases {
+		got, err := Format(in, i18n.English)
+		if err != nil {
+			t.Fatalf("Format(%q): %v", in, err)
+		}
+		if got != want {
+			t.Errorf("Format(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if _, err := Format("abc", i18n.English); !errors.IsValidationError(err) {
+		t.Errorf("Format(abc): error = %v, want ValidationError", err)
+	}
+}
+
+func TestFormatBigFloat(t *testing.T) {
+	cases := map[string]string{
+		"50000":            "50000.00",
+		"50000.5":          "50000.50",
+		"9999999999999.99": "9999999999999.99",
+	}
+	for in, want := range cases {
+		got, err := FormatBigFloat(in, i18n.English)
+		if err != nil {
+			t.Fatalf("FormatBigFloat(%q): %v", in, err)
+		}
+		if got != want {
+			t.Errorf("FormatBigFloat(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if _, err := FormatBigFloat("abc", i18n.English); !errors.IsValidationError(err) {
+		t.Errorf("FormatBigFloat(abc): error = %v, want ValidationError", err)
+	}
+}
+
+// TestFormatExactNearFloat64Limit pins that Format's rational arithmetic
+// keeps the last cents exact even beyond float64's 2^53 integer range,
+// matching FormatBigFloat.
+func TestFormatExactNearFloat64Limit(t *testing.T) {
+	const in = "9999999999999999.99"
+	got, err := Format(in, i18n.English)
+	if err != nil {
+		t.Fatalf("Format(%q): %v", in, err)
+	}
+	if got != in {
+		t.Errorf("Format(%q) = %q, want exact value", in, got)
+	}
+	big, err := FormatBigFloat(in, i18n.English)
+	if err != nil {
+		t.Fatalf("FormatBigFloat(%q): %v", in, err)
+	}
+	if got != big {
+		t.Errorf("Format(%q) = %q, FormatBigFloat = %q, want matching output", in, got, big)
+	}
+}
+
+// TestFormatBankersRounding pins the round-half-to-even behavior on exact
+// halves, which must not depend on IEEE 754 binary rounding.
+func TestFormatBankersRounding(t *testing.T) {
+	cases := map[string]string{
+		"50000.505":      "50000.50",
+		"50000.515":      "50000.52",
+		"0.015":          "0.02",
+		"0.025":          "0.02",
+		"1234567890.125": "1234567890.12",
+		"-0.015":         "-0.02",
+		"-50000.505":     "-50000.50",
+	}
+	for in, want := range cases {
+		got, err := Format(in, i18n.English)
+		if err != nil {
+			t.Fatalf("Format(%q): %v", in, err)
+		}
+		if got != want {
+			t.Errorf("Format(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFormatInt(t *testing.T) {
+	if got := FormatInt(50000); got != "50000.00" {
+		t.Errorf("FormatInt(50000) = %q, want 50000.00", got)
+	}
+	if got := FormatInt(0); got != "0.00" {
+		t.Errorf("FormatInt(0) = %q, want 0.00", got)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Format("9999999999999.99", i18n.English)
+	}
+}
+
+// BenchmarkFormatFloat64 is the old strconv.ParseFloat + %.2f path, kept
+// as the baseline for Format's rational-arithmetic overhead.
+func BenchmarkFormatFloat64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		f, err := strconv.ParseFloat("9999999999999.99", 64)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = fmt.Sprintf("%.2f", f)
+	}
+}
+
+func BenchmarkFormatBigFloat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FormatBigFloat("9999999999999.99", i18n.English)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want float64
+	}{
+		{json.Number("50000.5"), 50000.5},
+		{"50000.5", 50000.5},
+		{float64(50000.5), 50000.5},
+		{int64(50000), 50000},
+		{int(50000), 50000},
+	}
+	for _, tc := range cases {
+		got, err := ParseAmount(tc.in, i18n.English)
+		if err != nil {
+			t.Fatalf("ParseAmount(%v): %v", tc.in, err)
+		}
+		if got != tc.want {
+			t.Errorf("ParseAmount(%v) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+	for _, in := range []interface{}{"abc", json.Number("abc"), true, nil} {
+		if _, err := ParseAmount(in, i18n.English); !errors.IsValidationError(err) {
+			t.Errorf("ParseAmount(%v): error = %v, want ValidationError", in, err)
+		}
+	}
+}
+
+func TestParseAmountStrict(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want string
+	}{
+		{json.Number("50000.5"), "50000.50"},
+		{"50000", "50000.00"},
+		{float64(50000.5), "50000.50"},
+		{int64(50000), "50000.00"},
+		{int(50000), "50000.00"},
+		// Big string amounts keep their exact value.
+		{"9999999999999999.99", "9999999999999999.99"},
+	}
+	for _, tc := range cases {
+		got, err := ParseAmountStrict(tc.in, i18n.English)
+		if err != nil {
+			t.Fatalf("ParseAmountStrict(%v): %v", tc.in, err)
+		}
+		if got != tc.want {
+			t.Errorf("ParseAmountStrict(%v) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+	for _, in := range []interface{}{"abc", json.Number("abc"), true, nil} {
+		if _, err := ParseAmountStrict(in, i18n.English); !errors.IsValidationError(err) {
+			t.Errorf("ParseAmountStrict(%v): error = %v, want ValidationError", in, err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Package sanitize exposes the PII-masking helpers the SDK uses for its
+// own log output, so applications can apply the same masking in webhook
+// handlers and audit logs.
+package sanitize
+
+import (
+	"github.com/nikon1313/gspay-go-sdk/src/internal/sanitize"
+)
+
+// AccountNumber masks a bank account number, keeping only the last four
+// digits, e.g. "1234567890" becomes "****7890".
+func AccountNumber(s string) string {
+	return sanitize.AccountNumber(s)
+}
+
+// AccountName reduces an account holder name to initials, e.g. "John Doe"
+// becomes "J*** D***".
+func AccountName(s string) string {
+	return sanitize.AccountName(s)
+}
+
+// Endpoint redacts the operator auth key embedded in an endpoint path,
+// e.g. "/v2/integrations/operators/abc123/idr/payment" becomes
+// "/v2/integrations/operators/****/idr/payment".
+func Endpoint(s string) string {
+	return sanitize.Endpoint(s)
+}
+
+// TransactionID masks a transaction ID, keeping the first three and last
+// two characters, e.g. "TXN12345" becomes "TXN***45". IDs too short to
+// mask meaningfully are fully replaced.
+func TransactionID(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) <= 5 {
+		return "***"
+	}
+	return s[:3] + "***" + s[len(s)-2:]
+}
+
+// SignatureKey masks a signature or secret key, keeping only the first
+// four characters, e.g. "a1b2c3d4..." becomes "a1b2****".
+func SignatureKey(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) <= 4 {
+		return "****"
+	}
+	return s[:4] + "****"
+}
@@ -0,0 +1,67 @@
+package sanitize
+
+import "testing"
+
+func TestAccountNumber(t *testing.T) {
+	cases := map[string]string{
+		"":           "",
+		"123":        "****",
+		"1234":       "****",
+		"1234567890": "****7890",
+	}
+	for in, want := range cases {
+		if got := AccountNumber(in); got != want {
+			t.Errorf("AccountNumber(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestAccountName(t *testing.T) {
+	cases := map[string]string{
+		"":         "",
+		"John":     "J***",
+		"John Doe": "J*** D***",
+	}
+	for in, want := range cases {
+		if got := AccountName(in); got != want {
+			t.Errorf("AccountName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	in := "/v2/integrations/operators/abc123/idr/payment"
+	want := "/v2/integrations/operators/****/idr/payment"
+	if got := Endpoint(in); got != want {
+		t.Errorf("Endpoint(%q) = %q, want %q", in, got, want)
+	}
+}
+
+func TestTransactionID(t *testing.T) {
+	cases := map[string]string{
+		"":            "",
+		"TXN1":        "***",
+		"TXN12":       "***",
+		"TXN12345":    "TXN***45",
+		"ORDER-98765": "ORD***65",
+	}
+	for in, want := range cases {
+		if got := TransactionID(in); got != want {
+			t.Errorf("TransactionID(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSignatureKey(t *testing.T) {
+	cases := map[string]string{
+		"":                                 "",
+		"ab":                               "****",
+		"a1b2":                             "****",
+		"a1b2c3d4e5f6a7b8c9d0a1b2c3d4e5f6": "a1b2****",
+	}
+	for in, want := range cases {
+		if got := SignatureKey(in); got != want {
+			t.Errorf("SignatureKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Package i18n provides localized messages for SDK errors and log output.
+//
+// All user-facing strings produced by the SDK are looked up through this
+// package so that integrators can surface errors to operators in their
+// own language. English is always the fallback language.
+package i18n
+
+// Language identifies a supported message language using its ISO 639-1 code.
+type Language string
+
+const (
+	// English is the default language for all SDK messages.
+	English Language = "en"
+	// Indonesian covers Bahasa Indonesia.
+	Indonesian Language = "id"
+	// Thai covers ภาษาไทย.
+	Thai Language = "th"
+	// Malay covers Bahasa Melayu.
+	Malay Language = "ms"
+)
+
+// IsValid reports whether the language has a registered translation table.
+func (l Language) IsValid() bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	_, ok := translations[l]
+	return ok
+}
+
+// Get returns the message for key in the requested language. Unknown
+// languages and untranslated keys fall back to English; an unknown key
+// returns the key itself so callers never receive an empty string.
+func Get(lang Language, key MessageKey) string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	if msgs, ok := translations[lang]; ok {
+		if msg, ok := msgs[key]; ok {
+			return msg
+		}
+	}
+	if msg, ok := translations[English][key]; ok {
+		return msg
+	}
+	return string(key)
+}
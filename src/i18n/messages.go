@@ -0,0 +1,571 @@
+package i18n
+
+// MessageKey identifies a translatable message. Keys prefixed with Msg are
+// error and format strings; keys prefixed with Log are structured log
+// messages emitted by the services.
+type MessageKey string
+
+// Error and format message keys.
+const (
+	MsgInvalidTransactionID  MessageKey = "invalid_transaction_id"
+	MsgInvalidAmount         MessageKey = "invalid_amount"
+	MsgInvalidAmountFormat   MessageKey = "invalid_amount_format"
+	MsgInvalidBankCode       MessageKey = "invalid_bank_code"
+	MsgInvalidChannel        MessageKey = "invalid_channel"
+	MsgInvalidNetwork        MessageKey = "invalid_network"
+	MsgMissingCallbackField  MessageKey = "missing_callback_field"
+	MsgSignatureMismatch     MessageKey = "signature_mismatch"
+	MsgDuplicateCallback     MessageKey = "duplicate_callback"
+	MsgStatusProcessing      MessageKey = "status_processing"
+	MsgStatusPending         MessageKey = "status_pending"
+	MsgStatusSuccess         MessageKey = "status_success"
+	MsgStatusFailed          MessageKey = "status_failed"
+	MsgStatusTimeout         MessageKey = "status_timeout"
+	MsgStatusUnknown         MessageKey = "status_unknown"
+	MsgInvalidJSON           MessageKey = "invalid_json"
+	MsgRequestFailed         MessageKey = "request_failed"
+	MsgUnauthenticated       MessageKey = "unauthenticated"
+	MsgRateLimited           MessageKey = "rate_limited"
+	MsgUnauthorizedIP        MessageKey = "unauthorized_ip"
+	MsgCircuitOpen           MessageKey = "circuit_open"
+	MsgInvalidHTTPMethod     MessageKey = "invalid_http_method"
+	MsgInvalidContentType    MessageKey = "invalid_content_type"
+	MsgCallbackTooLarge      MessageKey = "callback_too_large"
+	MsgResponseTooLarge      MessageKey = "response_too_large"
+	MsgTimeout               MessageKey = "timeout"
+	MsgConnectionRefused     MessageKey = "connection_refused"
+	MsgInvalidConfiguration  MessageKey = "invalid_configuration"
+	MsgInsecureURL           MessageKey = "insecure_url"
+	MsgReservedHeader        MessageKey = "reserved_header"
+	MsgInsufficientBalance   MessageKey = "insufficient_balance"
+	MsgEmptyQRCode           MessageKey = "empty_qr_code"
+	MsgClientDraining        MessageKey = "client_draining"
+	MsgInconsistentCallback  MessageKey = "inconsistent_callback"
+	MsgEndpointFormatFailure MessageKey = "endpoint_format_failure"
+	MsgCircuitOpenFormat     MessageKey = "circuit_open_format"
+	MsgRateLimitedFormat     MessageKey = "rate_limited_format"
+	MsgAPIErrorFormat        MessageKey = "api_error_format"
+	MsgAPIErrorFormatNoURL   MessageKey = "api_error_format_no_url"
+	MsgValidationFormat      MessageKey = "validation_format"
+	MsgRequiredField         MessageKey = "required_field"
+	MsgInvalidIdempotency    MessageKey = "invalid_idempotency_key"
+	MsgErrorGroupFormat      MessageKey = "error_group_format"
+	MsgErrorGroupElided      MessageKey = "error_group_elided"
+	MsgInvalidPagination     MessageKey = "invalid_pagination"
+)
+
+// Structured log message keys for the IDR payment service.
+const (
+	LogCreatingIDRPayment         MessageKey = "log_creating_idr_payment"
+	LogIDRPaymentCreated          MessageKey = "log_idr_payment_created"
+	LogCreatingIDRRefund          MessageKey = "log_creating_idr_refund"
+	LogIDRRefundCreated           MessageKey = "log_idr_refund_created"
+	LogQueryingIDRPaymentStatus   MessageKey = "log_querying_idr_payment_status"
+	LogIDRPaymentStatusRetrieved  MessageKey = "log_idr_payment_status_retrieved"
+	LogVerifyingIDRCallback       MessageKey = "log_verifying_idr_callback"
+	LogIDRCallbackVerified        MessageKey = "log_idr_callback_verified"
+	LogIDRCallbackIPFailed        MessageKey = "log_idr_callback_ip_failed"
+	LogIDRCallbackSignatureFailed MessageKey = "log_idr_callback_signature_failed"
+)
+
+// Structured log message keys for the USDT payment service.
+const (
+	LogCreatingUSDTPayment        MessageKey = "log_creating_usdt_payment"
+	LogUSDTPaymentCreated         MessageKey = "log_usdt_payment_created"
+	LogQueryingUSDTPaymentStatus  MessageKey = "log_querying_usdt_payment_status"
+	LogUSDTPaymentStatusRetrieved MessageKey = "log_usdt_payment_status_retrieved"
+	LogVerifyingUSDTCallback      MessageKey = "log_verifying_usdt_callback"
+	LogUSDTCallbackVerified       MessageKey = "log_usdt_callback_verified"
+	LogUSDTCallbackIPFailed       MessageKey = "log_usdt_callback_ip_failed"
+)
+
+// Structured log message keys for the MYR payment service.
+const (
+	LogCreatingMYRPayment         MessageKey = "log_creating_myr_payment"
+	LogMYRPaymentCreated          MessageKey = "log_myr_payment_created"
+	LogQueryingMYRPaymentStatus   MessageKey = "log_querying_myr_payment_status"
+	LogMYRPaymentStatusRetrieved  MessageKey = "log_myr_payment_status_retrieved"
+	LogVerifyingMYRCallback       MessageKey = "log_verifying_myr_callback"
+	LogMYRCallbackVerified        MessageKey = "log_myr_callback_verified"
+	LogMYRCallbackIPFailed        MessageKey = "log_myr_callback_ip_failed"
+	LogMYRCallbackSignatureFailed MessageKey = "log_myr_callback_signature_failed"
+)
+
+// Structured log message keys for the IDR payout service.
+const (
+	LogCreatingIDRPayout                MessageKey = "log_creating_idr_payout"
+	LogIDRPayoutCreated                 MessageKey = "log_idr_payout_created"
+	LogBalanceCheckFailed               MessageKey = "log_balance_check_failed"
+	LogQueryingIDRPayoutStatus          MessageKey = "log_querying_idr_payout_status"
+	LogIDRPayoutStatusRetrieved         MessageKey = "log_idr_payout_status_retrieved"
+	LogVerifyingIDRPayoutCallback       MessageKey = "log_verifying_idr_payout_callback"
+	LogIDRPayoutCallbackVerified        MessageKey = "log_idr_payout_callback_verified"
+	LogIDRPayoutCallbackIPFailed        MessageKey = "log_idr_payout_callback_ip_failed"
+	LogIDRPayoutCallbackSignatureFailed MessageKey = "log_idr_payout_callback_signature_failed"
+)
+
+// Structured log message keys for the balance service.
+const (
+	LogQueryingBalance  MessageKey = "log_querying_balance"
+	LogBalanceRetrieved MessageKey = "log_balance_retrieved"
+)
+
+// Structured log message keys for the MYR payout service.
+const (
+	LogCreatingMYRPayout        MessageKey = "log_creating_myr_payout"
+	LogMYRPayoutCreated         MessageKey = "log_myr_payout_created"
+	LogQueryingMYRPayoutStatus  MessageKey = "log_querying_myr_payout_status"
+	LogMYRPayoutStatusRetrieved MessageKey = "log_myr_payout_status_retrieved"
+)
+
+// Structured log message keys for the THB payout service.
+const (
+	LogCreatingTHBPayout        MessageKey = "log_creating_thb_payout"
+	LogTHBPayoutCreated         MessageKey = "log_thb_payout_created"
+	LogQueryingTHBPayoutStatus  MessageKey = "log_querying_thb_payout_status"
+	LogTHBPayoutStatusRetrieved MessageKey = "log_thb_payout_status_retrieved"
+)
+
+// Structured log message keys for the client transport.
+const (
+	LogConnectionPoolIgnored MessageKey = "log_connection_pool_ignored"
+	LogHTTP2ConfigFailed     MessageKey = "log_http2_config_failed"
+	LogProxyIgnored          MessageKey = "log_proxy_ignored"
+	LogInvalidProxyURL       MessageKey = "log_invalid_proxy_url"
+	LogBaseURLHasPath        MessageKey = "log_base_url_has_path"
+	LogInsecureBaseURL       MessageKey = "log_insecure_base_url"
+	LogDebugWriterOverride   MessageKey = "log_debug_writer_override"
+	LogLoggerOverride        MessageKey = "log_logger_override"
+	LogSensitiveContextKey   MessageKey = "log_sensitive_context_key"
+)
+
+var translations = map[Language]map[MessageKey]string{
+	English: {
+		MsgInvalidTransactionID:  "transaction ID must be between 5 and 20 characters",
+		MsgInvalidAmount:         "amount is below the minimum allowed",
+		MsgInvalidAmountFormat:   "amount has an invalid format",
+		MsgInvalidBankCode:       "bank code is not recognized",
+		MsgInvalidChannel:        "payment channel is not recognized",
+		MsgInvalidNetwork:        "USDT network is not supported",
+		MsgMissingCallbackField:  "callback is missing a required field",
+		MsgSignatureMismatch:     "signature verification failed",
+		MsgDuplicateCallback:     "callback already processed",
+		MsgStatusProcessing:      "Processing",
+		MsgStatusPending:         "Pending/Expired",
+		MsgStatusSuccess:         "Success",
+		MsgStatusFailed:          "Failed",
+		MsgStatusTimeout:         "Timeout",
+		MsgStatusUnknown:         "Unknown",
+		MsgInvalidJSON:           "response body is not valid JSON",
+		MsgRequestFailed:         "request to GSPAY2 failed",
+		MsgUnauthenticated:       "GSPAY2 rejected the operator credentials",
+		MsgRateLimited:           "request was rate limited by GSPAY2",
+		MsgUnauthorizedIP:        "callback source IP is not whitelisted",
+		MsgCircuitOpen:           "circuit breaker is open",
+		MsgInvalidHTTPMethod:     "callback must be delivered via POST",
+		MsgInvalidContentType:    "callback content type must be application/json",
+		MsgCallbackTooLarge:      "callback body exceeds the maximum allowed size",
+		MsgResponseTooLarge:      "response body exceeds the maximum allowed size",
+		MsgTimeout:               "request to GSPAY2 timed out",
+		MsgConnectionRefused:     "connection to GSPAY2 was refused",
+		MsgInvalidConfiguration:  "client configuration is invalid",
+		MsgInsecureURL:           "base URL must use https",
+		MsgReservedHeader:        "header is managed by the client and cannot be overridden",
+		MsgInsufficientBalance:   "settlement balance is lower than the payout amount",
+		MsgEmptyQRCode:           "GSPAY2 returned an empty QR code",
+		MsgClientDraining:        "client is draining and no longer accepts requests",
+		MsgInconsistentCallback:  "callback fields contradict each other",
+		MsgEndpointFormatFailure: "endpoint pattern could not be formatted",
+		MsgCircuitOpenFormat:     "gspay: circuit breaker is open, retry after %s",
+		MsgRateLimitedFormat:     "gspay: rate limited on %s, retry after %s",
+		MsgAPIErrorFormat:        "gspay: API error %d on %s: %s",
+		MsgAPIErrorFormatNoURL:   "gspay: API error %d: %s",
+		MsgValidationFormat:      "gspay: validation failed on field %q: %s",
+		MsgRequiredField:         "field is required",
+		MsgInvalidIdempotency:    "idempotency key must be non-empty and at most 64 characters",
+		MsgErrorGroupFormat:      "gspay: %d errors occurred: %s",
+		MsgErrorGroupElided:      "and %d more",
+		MsgInvalidPagination:     "pagination parameters are out of range",
+
+		LogCreatingIDRPayment:         "creating IDR payment",
+		LogIDRPaymentCreated:          "IDR payment created",
+		LogCreatingIDRRefund:          "creating IDR refund",
+		LogIDRRefundCreated:           "IDR refund created",
+		LogQueryingIDRPaymentStatus:   "querying IDR payment status",
+		LogIDRPaymentStatusRetrieved:  "IDR payment status retrieved",
+		LogVerifyingIDRCallback:       "verifying IDR payment callback",
+		LogIDRCallbackVerified:        "IDR payment callback verified",
+		LogIDRCallbackIPFailed:        "IDR payment callback rejected: source IP not whitelisted",
+		LogIDRCallbackSignatureFailed: "IDR payment callback rejected: signature mismatch",
+
+		LogCreatingUSDTPayment:        "creating USDT payment",
+		LogUSDTPaymentCreated:         "USDT payment created",
+		LogQueryingUSDTPaymentStatus:  "querying USDT payment status",
+		LogUSDTPaymentStatusRetrieved: "USDT payment status retrieved",
+		LogVerifyingUSDTCallback:      "verifying USDT payment callback",
+		LogUSDTCallbackVerified:       "USDT payment callback verified",
+		LogUSDTCallbackIPFailed:       "USDT payment callback rejected: source IP not whitelisted",
+
+		LogCreatingMYRPayment:         "creating MYR payment",
+		LogMYRPaymentCreated:          "MYR payment created",
+		LogQueryingMYRPaymentStatus:   "querying MYR payment status",
+		LogMYRPaymentStatusRetrieved:  "MYR payment status retrieved",
+		LogVerifyingMYRCallback:       "verifying MYR payment callback",
+		LogMYRCallbackVerified:        "MYR payment callback verified",
+		LogMYRCallbackIPFailed:        "MYR payment callback rejected: source IP not whitelisted",
+		LogMYRCallbackSignatureFailed: "MYR payment callback rejected: signature mismatch",
+
+		LogCreatingIDRPayout:                "creating IDR payout",
+		LogIDRPayoutCreated:                 "IDR payout created",
+		LogBalanceCheckFailed:               "balance pre-flight check failed; proceeding with payout",
+		LogQueryingIDRPayoutStatus:          "querying IDR payout status",
+		LogIDRPayoutStatusRetrieved:         "IDR payout status retrieved",
+		LogVerifyingIDRPayoutCallback:       "verifying IDR payout callback",
+		LogIDRPayoutCallbackVerified:        "IDR payout callback verified",
+		LogIDRPayoutCallbackIPFailed:        "IDR payout callback rejected: source IP not whitelisted",
+		LogIDRPayoutCallbackSignatureFailed: "IDR payout callback rejected: signature mismatch",
+
+		LogQueryingBalance:  "querying operator balance",
+		LogBalanceRetrieved: "operator balance retrieved",
+
+		LogCreatingMYRPayout:        "creating MYR payout",
+		LogMYRPayoutCreated:         "MYR payout created",
+		LogQueryingMYRPayoutStatus:  "querying MYR payout status",
+		LogMYRPayoutStatusRetrieved: "MYR payout status retrieved",
+		LogCreatingTHBPayout:        "creating THB payout",
+		LogTHBPayoutCreated:         "THB payout created",
+		LogQueryingTHBPayoutStatus:  "querying THB payout status",
+		LogTHBPayoutStatusRetrieved: "THB payout status retrieved",
+
+		LogConnectionPoolIgnored: "connection pool configuration ignored: custom HTTP client supplied",
+		LogHTTP2ConfigFailed:     "failed to enable HTTP/2 on the transport",
+		LogProxyIgnored:          "proxy configuration ignored: custom transport is not an *http.Transport",
+		LogInvalidProxyURL:       "invalid proxy URL ignored",
+		LogBaseURLHasPath:        "base URL contains a path; endpoint paths may double up",
+		LogInsecureBaseURL:       "base URL uses plain http; auth keys and signatures are sent unencrypted",
+		LogSensitiveContextKey:   "context propagation key looks credential-like; its value will be sent as a header",
+		LogDebugWriterOverride:   "configured logger replaced by the debug writer",
+		LogLoggerOverride:        "plain and structured loggers are mutually exclusive; the last configured one wins",
+	},
+	Indonesian: {
+		MsgInvalidTransactionID:  "ID transaksi harus antara 5 dan 20 karakter",
+		MsgInvalidAmount:         "jumlah di bawah minimum yang diizinkan",
+		MsgInvalidAmountFormat:   "format jumlah tidak valid",
+		MsgInvalidBankCode:       "kode bank tidak dikenali",
+		MsgInvalidChannel:        "kanal pembayaran tidak dikenali",
+		MsgInvalidNetwork:        "jaringan USDT tidak didukung",
+		MsgMissingCallbackField:  "callback kehilangan field yang wajib",
+		MsgSignatureMismatch:     "verifikasi tanda tangan gagal",
+		MsgDuplicateCallback:     "callback sudah diproses",
+		MsgStatusProcessing:      "Sedang diproses",
+		MsgStatusPending:         "Menunggu/Kedaluwarsa",
+		MsgStatusSuccess:         "Berhasil",
+		MsgStatusFailed:          "Gagal",
+		MsgStatusTimeout:         "Waktu habis",
+		MsgStatusUnknown:         "Tidak diketahui",
+		MsgInvalidJSON:           "isi respons bukan JSON yang valid",
+		MsgRequestFailed:         "permintaan ke GSPAY2 gagal",
+		MsgUnauthenticated:       "GSPAY2 menolak kredensial operator",
+		MsgRateLimited:           "permintaan dibatasi oleh GSPAY2",
+		MsgUnauthorizedIP:        "IP sumber callback tidak masuk daftar putih",
+		MsgCircuitOpen:           "pemutus sirkuit sedang terbuka",
+		MsgInvalidHTTPMethod:     "callback harus dikirim melalui POST",
+		MsgInvalidContentType:    "jenis konten callback harus application/json",
+		MsgCallbackTooLarge:      "badan callback melebihi ukuran maksimum yang diizinkan",
+		MsgResponseTooLarge:      "badan respons melebihi ukuran maksimum yang diizinkan",
+		MsgTimeout:               "permintaan ke GSPAY2 melewati batas waktu",
+		MsgConnectionRefused:     "koneksi ke GSPAY2 ditolak",
+		MsgInvalidConfiguration:  "konfigurasi klien tidak valid",
+		MsgInsecureURL:           "URL dasar harus menggunakan https",
+		MsgReservedHeader:        "header dikelola oleh klien dan tidak dapat diganti",
+		MsgInsufficientBalance:   "saldo penyelesaian lebih rendah dari jumlah pencairan",
+		MsgEmptyQRCode:           "GSPAY2 mengembalikan kode QR kosong",
+		MsgClientDraining:        "klien sedang dihentikan dan tidak menerima permintaan baru",
+		MsgInconsistentCallback:  "kolom callback saling bertentangan",
+		MsgEndpointFormatFailure: "pola endpoint tidak dapat diformat",
+		MsgCircuitOpenFormat:     "gspay: pemutus sirkuit sedang terbuka, coba lagi setelah %s",
+		MsgRateLimitedFormat:     "gspay: permintaan pada %s dibatasi, coba lagi setelah %s",
+		MsgAPIErrorFormat:        "gspay: kesalahan API %d pada %s: %s",
+		MsgAPIErrorFormatNoURL:   "gspay: kesalahan API %d: %s",
+		MsgValidationFormat:      "gspay: validasi gagal pada field %q: %s",
+		MsgRequiredField:         "field wajib diisi",
+		MsgInvalidIdempotency:    "kunci idempotensi harus diisi dan maksimal 64 karakter",
+		MsgErrorGroupFormat:      "gspay: terjadi %d kesalahan: %s",
+		MsgErrorGroupElided:      "dan %d lainnya",
+		MsgInvalidPagination:     "parameter paginasi di luar jangkauan",
+
+		LogCreatingIDRPayment:         "membuat pembayaran IDR",
+		LogIDRPaymentCreated:          "pembayaran IDR dibuat",
+		LogCreatingIDRRefund:          "membuat pengembalian dana IDR",
+		LogIDRRefundCreated:           "pengembalian dana IDR dibuat",
+		LogQueryingIDRPaymentStatus:   "menanyakan status pembayaran IDR",
+		LogIDRPaymentStatusRetrieved:  "status pembayaran IDR diterima",
+		LogVerifyingIDRCallback:       "memverifikasi callback pembayaran IDR",
+		LogIDRCallbackVerified:        "callback pembayaran IDR terverifikasi",
+		LogIDRCallbackIPFailed:        "callback pembayaran IDR ditolak: IP sumber tidak masuk daftar putih",
+		LogIDRCallbackSignatureFailed: "callback pembayaran IDR ditolak: tanda tangan tidak cocok",
+
+		LogCreatingUSDTPayment:        "membuat pembayaran USDT",
+		LogUSDTPaymentCreated:         "pembayaran USDT dibuat",
+		LogQueryingUSDTPaymentStatus:  "menanyakan status pembayaran USDT",
+		LogUSDTPaymentStatusRetrieved: "status pembayaran USDT diterima",
+		LogVerifyingUSDTCallback:      "memverifikasi callback pembayaran USDT",
+		LogUSDTCallbackVerified:       "callback pembayaran USDT terverifikasi",
+		LogUSDTCallbackIPFailed:       "callback pembayaran USDT ditolak: IP sumber tidak masuk daftar putih",
+
+		LogCreatingMYRPayment:         "membuat pembayaran MYR",
+		LogMYRPaymentCreated:          "pembayaran MYR dibuat",
+		LogQueryingMYRPaymentStatus:   "menanyakan status pembayaran MYR",
+		LogMYRPaymentStatusRetrieved:  "status pembayaran MYR diterima",
+		LogVerifyingMYRCallback:       "memverifikasi callback pembayaran MYR",
+		LogMYRCallbackVerified:        "callback pembayaran MYR terverifikasi",
+		LogMYRCallbackIPFailed:        "callback pembayaran MYR ditolak: IP sumber tidak masuk daftar putih",
+		LogMYRCallbackSignatureFailed: "callback pembayaran MYR ditolak: tanda tangan tidak cocok",
+
+		LogCreatingIDRPayout:                "membuat pencairan IDR",
+		LogIDRPayoutCreated:                 "pencairan IDR dibuat",
+		LogBalanceCheckFailed:               "pemeriksaan saldo awal gagal; pencairan tetap dilanjutkan",
+		LogQueryingIDRPayoutStatus:          "menanyakan status pencairan IDR",
+		LogIDRPayoutStatusRetrieved:         "status pencairan IDR diterima",
+		LogVerifyingIDRPayoutCallback:       "memverifikasi callback pencairan IDR",
+		LogIDRPayoutCallbackVerified:        "callback pencairan IDR terverifikasi",
+		LogIDRPayoutCallbackIPFailed:        "callback pencairan IDR ditolak: IP sumber tidak masuk daftar putih",
+		LogIDRPayoutCallbackSignatureFailed: "callback pencairan IDR ditolak: tanda tangan tidak cocok",
+
+		LogQueryingBalance:  "menanyakan saldo operator",
+		LogBalanceRetrieved: "saldo operator berhasil diambil",
+
+		LogCreatingMYRPayout:        "membuat pencairan MYR",
+		LogMYRPayoutCreated:         "pencairan MYR dibuat",
+		LogQueryingMYRPayoutStatus:  "menanyakan status pencairan MYR",
+		LogMYRPayoutStatusRetrieved: "status pencairan MYR diterima",
+		LogCreatingTHBPayout:        "membuat pencairan THB",
+		LogTHBPayoutCreated:         "pencairan THB dibuat",
+		LogQueryingTHBPayoutStatus:  "menanyakan status pencairan THB",
+		LogTHBPayoutStatusRetrieved: "status pencairan THB diterima",
+
+		LogConnectionPoolIgnored: "konfigurasi pool koneksi diabaikan: klien HTTP kustom diberikan",
+		LogHTTP2ConfigFailed:     "gagal mengaktifkan HTTP/2 pada transport",
+		LogProxyIgnored:          "konfigurasi proxy diabaikan: transport kustom bukan *http.Transport",
+		LogInvalidProxyURL:       "URL proxy tidak valid diabaikan",
+		LogBaseURLHasPath:        "URL dasar berisi path; path endpoint bisa terduplikasi",
+		LogInsecureBaseURL:       "URL dasar memakai http biasa; kunci auth dan tanda tangan dikirim tanpa enkripsi",
+		LogSensitiveContextKey:   "kunci propagasi context tampak seperti kredensial; nilainya akan dikirim sebagai header",
+		LogDebugWriterOverride:   "logger yang dikonfigurasi digantikan oleh debug writer",
+		LogLoggerOverride:        "logger biasa dan terstruktur tidak dapat digunakan bersamaan; yang dikonfigurasi terakhir yang berlaku",
+	},
+	Thai: {
+		MsgInvalidTransactionID:  "รหัสธุรกรรมต้องมีความยาวระหว่าง 5 ถึง 20 ตัวอักษร",
+		MsgInvalidAmount:         "จำนวนเงินต่ำกว่าขั้นต่ำที่อนุญาต",
+		MsgInvalidAmountFormat:   "รูปแบบจำนวนเงินไม่ถูกต้อง",
+		MsgInvalidBankCode:       "ไม่รู้จักรหัสธนาคาร",
+		MsgInvalidChannel:        "ไม่รู้จักช่องทางการชำระเงิน",
+		MsgInvalidNetwork:        "ไม่รองรับเครือข่าย USDT นี้",
+		MsgMissingCallbackField:  "callback ขาดฟิลด์ที่จำเป็น",
+		MsgSignatureMismatch:     "การตรวจสอบลายเซ็นล้มเหลว",
+		MsgDuplicateCallback:     "ประมวลผล callback นี้ไปแล้ว",
+		MsgStatusProcessing:      "กำลังดำเนินการ",
+		MsgStatusPending:         "รอดำเนินการ/หมดอายุ",
+		MsgStatusSuccess:         "สำเร็จ",
+		MsgStatusFailed:          "ล้มเหลว",
+		MsgStatusTimeout:         "หมดเวลา",
+		MsgStatusUnknown:         "ไม่ทราบสถานะ",
+		MsgInvalidJSON:           "เนื้อหาการตอบกลับไม่ใช่ JSON ที่ถูกต้อง",
+		MsgRequestFailed:         "คำขอไปยัง GSPAY2 ล้มเหลว",
+		MsgUnauthenticated:       "GSPAY2 ปฏิเสธข้อมูลรับรองของผู้ให้บริการ",
+		MsgRateLimited:           "คำขอถูกจำกัดอัตราโดย GSPAY2",
+		MsgUnauthorizedIP:        "IP ต้นทางของ callback ไม่อยู่ในรายการที่อนุญาต",
+		MsgCircuitOpen:           "เบรกเกอร์วงจรเปิดอยู่",
+		MsgInvalidHTTPMethod:     "callback ต้องส่งผ่าน POST",
+		MsgInvalidContentType:    "ประเภทเนื้อหาของ callback ต้องเป็น application/json",
+		MsgCallbackTooLarge:      "ขนาดเนื้อหา callback เกินขนาดสูงสุดที่อนุญาต",
+		MsgResponseTooLarge:      "ขนาดเนื้อหาการตอบกลับเกินขนาดสูงสุดที่อนุญาต",
+		MsgTimeout:               "คำขอไปยัง GSPAY2 หมดเวลา",
+		MsgConnectionRefused:     "การเชื่อมต่อไปยัง GSPAY2 ถูกปฏิเสธ",
+		MsgInvalidConfiguration:  "การตั้งค่าไคลเอนต์ไม่ถูกต้อง",
+		MsgInsecureURL:           "URL หลักต้องใช้ https",
+		MsgReservedHeader:        "header นี้ถูกจัดการโดยไคลเอนต์และไม่สามารถแทนที่ได้",
+		MsgInsufficientBalance:   "ยอดคงเหลือสำหรับชำระต่ำกว่าจำนวนเงินที่จะโอนออก",
+		MsgEmptyQRCode:           "GSPAY2 ส่งรหัส QR ว่างกลับมา",
+		MsgClientDraining:        "ไคลเอนต์กำลังปิดตัวและไม่รับคำขอใหม่",
+		MsgInconsistentCallback:  "ฟิลด์ของ callback ขัดแย้งกันเอง",
+		MsgEndpointFormatFailure: "ไม่สามารถจัดรูปแบบ endpoint ได้",
+		MsgCircuitOpenFormat:     "gspay: เบรกเกอร์วงจรเปิดอยู่ ลองใหม่หลังจาก %s",
+		MsgRateLimitedFormat:     "gspay: คำขอไปยัง %s ถูกจำกัดอัตรา ลองใหม่หลังจาก %s",
+		MsgAPIErrorFormat:        "gspay: ข้อผิดพลาด API %d ที่ %s: %s",
+		MsgAPIErrorFormatNoURL:   "gspay: ข้อผิดพลาด API %d: %s",
+		MsgValidationFormat:      "gspay: การตรวจสอบฟิลด์ %q ล้มเหลว: %s",
+		MsgRequiredField:         "ต้องระบุฟิลด์นี้",
+		MsgInvalidIdempotency:    "คีย์ idempotency ต้องไม่ว่างและยาวไม่เกิน 64 ตัวอักษร",
+		MsgErrorGroupFormat:      "gspay: เกิดข้อผิดพลาด %d รายการ: %s",
+		MsgErrorGroupElided:      "และอีก %d รายการ",
+		MsgInvalidPagination:     "พารามิเตอร์การแบ่งหน้าอยู่นอกช่วงที่อนุญาต",
+
+		LogCreatingIDRPayment:         "กำลังสร้างการชำระเงิน IDR",
+		LogIDRPaymentCreated:          "สร้างการชำระเงิน IDR แล้ว",
+		LogCreatingIDRRefund:          "กำลังสร้างการคืนเงิน IDR",
+		LogIDRRefundCreated:           "สร้างการคืนเงิน IDR แล้ว",
+		LogQueryingIDRPaymentStatus:   "กำลังสอบถามสถานะการชำระเงิน IDR",
+		LogIDRPaymentStatusRetrieved:  "ได้รับสถานะการชำระเงิน IDR แล้ว",
+		LogVerifyingIDRCallback:       "กำลังตรวจสอบ callback การชำระเงิน IDR",
+		LogIDRCallbackVerified:        "ตรวจสอบ callback การชำระเงิน IDR แล้ว",
+		LogIDRCallbackIPFailed:        "ปฏิเสธ callback การชำระเงิน IDR: IP ต้นทางไม่อยู่ในรายการที่อนุญาต",
+		LogIDRCallbackSignatureFailed: "ปฏิเสธ callback การชำระเงิน IDR: ลายเซ็นไม่ตรงกัน",
+
+		LogCreatingUSDTPayment:        "กำลังสร้างการชำระเงิน USDT",
+		LogUSDTPaymentCreated:         "สร้างการชำระเงิน USDT แล้ว",
+		LogQueryingUSDTPaymentStatus:  "กำลังสอบถามสถานะการชำระเงิน USDT",
+		LogUSDTPaymentStatusRetrieved: "ได้รับสถานะการชำระเงิน USDT แล้ว",
+		LogVerifyingUSDTCallback:      "กำลังตรวจสอบ callback การชำระเงิน USDT",
+		LogUSDTCallbackVerified:       "ตรวจสอบ callback การชำระเงิน USDT แล้ว",
+		LogUSDTCallbackIPFailed:       "ปฏิเสธ callback การชำระเงิน USDT: IP ต้นทางไม่อยู่ในรายการที่อนุญาต",
+
+		LogCreatingMYRPayment:         "กำลังสร้างการชำระเงิน MYR",
+		LogMYRPaymentCreated:          "สร้างการชำระเงิน MYR แล้ว",
+		LogQueryingMYRPaymentStatus:   "กำลังสอบถามสถานะการชำระเงิน MYR",
+		LogMYRPaymentStatusRetrieved:  "ได้รับสถานะการชำระเงิน MYR แล้ว",
+		LogVerifyingMYRCallback:       "กำลังตรวจสอบ callback การชำระเงิน MYR",
+		LogMYRCallbackVerified:        "ตรวจสอบ callback การชำระเงิน MYR แล้ว",
+		LogMYRCallbackIPFailed:        "ปฏิเสธ callback การชำระเงิน MYR: IP ต้นทางไม่อยู่ในรายการที่อนุญาต",
+		LogMYRCallbackSignatureFailed: "ปฏิเสธ callback การชำระเงิน MYR: ลายเซ็นไม่ตรงกัน",
+
+		LogCreatingIDRPayout:                "กำลังสร้างการโอนเงินออก IDR",
+		LogIDRPayoutCreated:                 "สร้างการโอนเงินออก IDR แล้ว",
+		LogBalanceCheckFailed:               "การตรวจสอบยอดคงเหลือล่วงหน้าล้มเหลว จะดำเนินการโอนเงินออกต่อไป",
+		LogQueryingIDRPayoutStatus:          "กำลังสอบถามสถานะการโอนเงินออก IDR",
+		LogIDRPayoutStatusRetrieved:         "ได้รับสถานะการโอนเงินออก IDR แล้ว",
+		LogVerifyingIDRPayoutCallback:       "กำลังตรวจสอบ callback การโอนเงินออก IDR",
+		LogIDRPayoutCallbackVerified:        "ตรวจสอบ callback การโอนเงินออก IDR แล้ว",
+		LogIDRPayoutCallbackIPFailed:        "ปฏิเสธ callback การโอนเงินออก IDR: IP ต้นทางไม่อยู่ในรายการที่อนุญาต",
+		LogIDRPayoutCallbackSignatureFailed: "ปฏิเสธ callback การโอนเงินออก IDR: ลายเซ็นไม่ตรงกัน",
+
+		LogQueryingBalance:  "กำลังสอบถามยอดคงเหลือของผู้ให้บริการ",
+		LogBalanceRetrieved: "ได้รับยอดคงเหลือของผู้ให้บริการแล้ว",
+
+		LogCreatingMYRPayout:        "กำลังสร้างการโอนเงินออก MYR",
+		LogMYRPayoutCreated:         "สร้างการโอนเงินออก MYR แล้ว",
+		LogQueryingMYRPayoutStatus:  "กำลังสอบถามสถานะการโอนเงินออก MYR",
+		LogMYRPayoutStatusRetrieved: "ได้รับสถานะการโอนเงินออก MYR แล้ว",
+		LogCreatingTHBPayout:        "กำลังสร้างการโอนเงินออก THB",
+		LogTHBPayoutCreated:         "สร้างการโอนเงินออก THB แล้ว",
+		LogQueryingTHBPayoutStatus:  "กำลังสอบถามสถานะการโอนเงินออก THB",
+		LogTHBPayoutStatusRetrieved: "ได้รับสถานะการโอนเงินออก THB แล้ว",
+
+		LogConnectionPoolIgnored: "ละเว้นการตั้งค่า connection pool: มีการระบุไคลเอนต์ HTTP กำหนดเอง",
+		LogHTTP2ConfigFailed:     "ไม่สามารถเปิดใช้งาน HTTP/2 บน transport",
+		LogProxyIgnored:          "ละเว้นการตั้งค่าพร็อกซี: transport กำหนดเองไม่ใช่ *http.Transport",
+		LogInvalidProxyURL:       "ละเว้น URL พร็อกซีที่ไม่ถูกต้อง",
+		LogBaseURLHasPath:        "URL หลักมี path อยู่ อาจทำให้ path ของ endpoint ซ้ำซ้อน",
+		LogInsecureBaseURL:       "URL หลักใช้ http ธรรมดา คีย์และลายเซ็นจะถูกส่งโดยไม่เข้ารหัส",
+		LogSensitiveContextKey:   "คีย์การส่งต่อ context ดูเหมือนข้อมูลลับ ค่าของมันจะถูกส่งเป็นเฮดเดอร์",
+		LogDebugWriterOverride:   "logger ที่กำหนดไว้ถูกแทนที่ด้วย debug writer",
+		LogLoggerOverride:        "logger ธรรมดาและแบบมีโครงสร้างใช้ร่วมกันไม่ได้ ตัวที่กำหนดล่าสุดจะถูกใช้",
+	},
+	Malay: {
+		MsgInvalidTransactionID:  "ID transaksi mesti antara 5 hingga 20 aksara",
+		MsgInvalidAmount:         "jumlah di bawah minimum yang dibenarkan",
+		MsgInvalidAmountFormat:   "format jumlah tidak sah",
+		MsgInvalidBankCode:       "kod bank tidak dikenali",
+		MsgInvalidChannel:        "saluran pembayaran tidak dikenali",
+		MsgInvalidNetwork:        "rangkaian USDT tidak disokong",
+		MsgMissingCallbackField:  "callback kehilangan medan yang diperlukan",
+		MsgSignatureMismatch:     "pengesahan tandatangan gagal",
+		MsgDuplicateCallback:     "panggilan balik sudah diproses",
+		MsgStatusProcessing:      "Sedang diproses",
+		MsgStatusPending:         "Menunggu/Tamat tempoh",
+		MsgStatusSuccess:         "Berjaya",
+		MsgStatusFailed:          "Gagal",
+		MsgStatusTimeout:         "Tamat masa",
+		MsgStatusUnknown:         "Tidak diketahui",
+		MsgInvalidJSON:           "kandungan respons bukan JSON yang sah",
+		MsgRequestFailed:         "permintaan ke GSPAY2 gagal",
+		MsgUnauthenticated:       "GSPAY2 menolak kelayakan pengendali",
+		MsgRateLimited:           "permintaan dihadkan kadarnya oleh GSPAY2",
+		MsgUnauthorizedIP:        "IP sumber callback tiada dalam senarai putih",
+		MsgCircuitOpen:           "pemutus litar sedang terbuka",
+		MsgInvalidHTTPMethod:     "callback mesti dihantar melalui POST",
+		MsgInvalidContentType:    "jenis kandungan callback mesti application/json",
+		MsgCallbackTooLarge:      "badan callback melebihi saiz maksimum yang dibenarkan",
+		MsgResponseTooLarge:      "badan respons melebihi saiz maksimum yang dibenarkan",
+		MsgTimeout:               "permintaan ke GSPAY2 tamat masa",
+		MsgConnectionRefused:     "sambungan ke GSPAY2 ditolak",
+		MsgInvalidConfiguration:  "konfigurasi klien tidak sah",
+		MsgInsecureURL:           "URL asas mesti menggunakan https",
+		MsgReservedHeader:        "header diuruskan oleh klien dan tidak boleh diganti",
+		MsgInsufficientBalance:   "baki penyelesaian lebih rendah daripada jumlah pengeluaran",
+		MsgEmptyQRCode:           "GSPAY2 memulangkan kod QR kosong",
+		MsgClientDraining:        "klien sedang ditutup dan tidak menerima permintaan baru",
+		MsgInconsistentCallback:  "medan callback saling bercanggah",
+		MsgEndpointFormatFailure: "corak endpoint tidak dapat diformat",
+		MsgCircuitOpenFormat:     "gspay: pemutus litar sedang terbuka, cuba lagi selepas %s",
+		MsgRateLimitedFormat:     "gspay: permintaan pada %s dihadkan kadarnya, cuba lagi selepas %s",
+		MsgAPIErrorFormat:        "gspay: ralat API %d pada %s: %s",
+		MsgAPIErrorFormatNoURL:   "gspay: ralat API %d: %s",
+		MsgValidationFormat:      "gspay: pengesahan gagal pada medan %q: %s",
+		MsgRequiredField:         "medan ini diperlukan",
+		MsgInvalidIdempotency:    "kunci idempotensi mesti diisi dan tidak melebihi 64 aksara",
+		MsgErrorGroupFormat:      "gspay: %d ralat berlaku: %s",
+		MsgErrorGroupElided:      "dan %d lagi",
+		MsgInvalidPagination:     "parameter penomboran halaman di luar julat",
+
+		LogCreatingIDRPayment:         "mencipta pembayaran IDR",
+		LogIDRPaymentCreated:          "pembayaran IDR dicipta",
+		LogCreatingIDRRefund:          "mencipta bayaran balik IDR",
+		LogIDRRefundCreated:           "bayaran balik IDR dicipta",
+		LogQueryingIDRPaymentStatus:   "menyemak status pembayaran IDR",
+		LogIDRPaymentStatusRetrieved:  "status pembayaran IDR diterima",
+		LogVerifyingIDRCallback:       "mengesahkan callback pembayaran IDR",
+		LogIDRCallbackVerified:        "callback pembayaran IDR disahkan",
+		LogIDRCallbackIPFailed:        "callback pembayaran IDR ditolak: IP sumber tiada dalam senarai putih",
+		LogIDRCallbackSignatureFailed: "callback pembayaran IDR ditolak: tandatangan tidak sepadan",
+
+		LogCreatingUSDTPayment:        "mencipta pembayaran USDT",
+		LogUSDTPaymentCreated:         "pembayaran USDT dicipta",
+		LogQueryingUSDTPaymentStatus:  "menyemak status pembayaran USDT",
+		LogUSDTPaymentStatusRetrieved: "status pembayaran USDT diterima",
+		LogVerifyingUSDTCallback:      "mengesahkan callback pembayaran USDT",
+		LogUSDTCallbackVerified:       "callback pembayaran USDT disahkan",
+		LogUSDTCallbackIPFailed:       "callback pembayaran USDT ditolak: IP sumber tiada dalam senarai putih",
+
+		LogCreatingMYRPayment:         "mencipta pembayaran MYR",
+		LogMYRPaymentCreated:          "pembayaran MYR dicipta",
+		LogQueryingMYRPaymentStatus:   "menyemak status pembayaran MYR",
+		LogMYRPaymentStatusRetrieved:  "status pembayaran MYR diterima",
+		LogVerifyingMYRCallback:       "mengesahkan callback pembayaran MYR",
+		LogMYRCallbackVerified:        "callback pembayaran MYR disahkan",
+		LogMYRCallbackIPFailed:        "callback pembayaran MYR ditolak: IP sumber tiada dalam senarai putih",
+		LogMYRCallbackSignatureFailed: "callback pembayaran MYR ditolak: tandatangan tidak sepadan",
+
+		LogCreatingIDRPayout:                "mencipta pengeluaran IDR",
+		LogIDRPayoutCreated:                 "pengeluaran IDR dicipta",
+		LogBalanceCheckFailed:               "semakan baki awal gagal; pengeluaran diteruskan",
+		LogQueryingIDRPayoutStatus:          "menyemak status pengeluaran IDR",
+		LogIDRPayoutStatusRetrieved:         "status pengeluaran IDR diterima",
+		LogVerifyingIDRPayoutCallback:       "mengesahkan callback pengeluaran IDR",
+		LogIDRPayoutCallbackVerified:        "callback pengeluaran IDR disahkan",
+		LogIDRPayoutCallbackIPFailed:        "callback pengeluaran IDR ditolak: IP sumber tiada dalam senarai putih",
+		LogIDRPayoutCallbackSignatureFailed: "callback pengeluaran IDR ditolak: tandatangan tidak sepadan",
+
+		LogQueryingBalance:  "menyemak baki operator",
+		LogBalanceRetrieved: "baki operator diterima",
+
+		LogCreatingMYRPayout:        "mencipta pengeluaran MYR",
+		LogMYRPayoutCreated:         "pengeluaran MYR dicipta",
+		LogQueryingMYRPayoutStatus:  "menyemak status pengeluaran MYR",
+		LogMYRPayoutStatusRetrieved: "status pengeluaran MYR diterima",
+		LogCreatingTHBPayout:        "mencipta pengeluaran THB",
+		LogTHBPayoutCreated:         "pengeluaran THB dicipta",
+		LogQueryingTHBPayoutStatus:  "menyemak status pengeluaran THB",
+		LogTHBPayoutStatusRetrieved: "status pengeluaran THB diterima",
+
+		LogConnectionPoolIgnored: "konfigurasi kolam sambungan diabaikan: klien HTTP tersuai diberikan",
+		LogHTTP2ConfigFailed:     "gagal mengaktifkan HTTP/2 pada transport",
+		LogProxyIgnored:          "konfigurasi proksi diabaikan: transport tersuai bukan *http.Transport",
+		LogInvalidProxyURL:       "URL proksi tidak sah diabaikan",
+		LogBaseURLHasPath:        "URL asas mengandungi laluan; laluan endpoint mungkin berganda",
+		LogInsecureBaseURL:       "URL asas menggunakan http biasa; kunci auth dan tandatangan dihantar tanpa penyulitan",
+		LogSensitiveContextKey:   "kunci propagasi context kelihatan seperti kelayakan; nilainya akan dihantar sebagai header",
+		LogDebugWriterOverride:   "logger yang dikonfigurasi digantikan oleh debug writer",
+		LogLoggerOverride:        "logger biasa dan berstruktur saling eksklusif; yang dikonfigurasi terakhir digunakan",
+	},
+}
@@ -0,0 +1,46 @@
+package i18n
+
+import "testing"
+
+func TestThaiAndMalayAreValid(t *testing.T) {
+	for _, lang := range []Language{Thai, Malay} {
+		if !lang.IsValid() {
+			t.Errorf("%s.IsValid() = false, want true", lang)
+		}
+	}
+	supported := SupportedLanguages()
+	found := map[Language]bool{}
+	for _, lang := range supported {
+		found[lang] = true
+	}
+	if !found[Thai] || !found[Malay] {
+		t.Errorf("SupportedLanguages() = %v, want Thai and Malay included", supported)
+	}
+}
+
+func TestThaiTranslationDiffersFromEnglish(t *testing.T) {
+	got := Get(Thai, MsgInvalidTransactionID)
+	if got == "" {
+		t.Fatal("Get(Thai, MsgInvalidTransactionID) is empty")
+	}
+	if got == Get(English, MsgInvalidTransactionID) {
+		t.Errorf("Thai translation %q matches English, want a translated string", got)
+	}
+}
+
+func TestAllKeysTranslatedInEveryLanguage(t *testing.T) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	english := translations[English]
+	for _, lang := range []Language{Indonesian, Thai, Malay} {
+		table := translations[lang]
+		if len(table) != len(english) {
+			t.Errorf("%s table has %d entries, English has %d", lang, len(table), len(english))
+		}
+		for key := range english {
+			if table[key] == "" {
+				t.Errorf("%s is missing a translation for %q", lang, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package i18n
+
+import (
+	"sort"
+	"sync"
+)
+
+// registryMu guards the translations map for concurrent registration and
+// lookup. Reads vastly outnumber writes, so a RWMutex keeps Get cheap.
+var registryMu sync.RWMutex
+
+// RegisterTranslation adds or overwrites a single translation at runtime.
+// It can override built-in messages or attach messages to a new language.
+func RegisterTranslation(lang Language, key MessageKey, message string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	msgs, ok := translations[lang]
+	if !ok {
+		msgs = make(map[MessageKey]string)
+		translations[lang] = msgs
+	}
+	msgs[key] = message
+}
+
+// RegisterLanguage registers a whole translation table for a language,
+// merging over any existing entries for that language.
+func RegisterLanguage(code Language, messages map[MessageKey]string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	msgs, ok := translations[code]
+	if !ok {
+		msgs = make(map[MessageKey]string, len(messages))
+		translations[code] = msgs
+	}
+	for key, message := range messages {
+		msgs[key] = message
+	}
+}
+
+// SupportedLanguages returns the registered language codes in sorted order.
+func SupportedLanguages() []Language {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	langs := make([]Language, 0, len(translations))
+	for lang := range translations {
+		langs = append(langs, lang)
+	}
+	sort.Slice(langs, func(i, j int) bool { return langs[i] < langs[j] })
+	return langs
+}
+
+// GetAll returns a copy of every translation registered for lang. Callers
+// may mutate the result freely.
+func GetAll(lang Language) map[MessageKey]string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	msgs := translations[lang]
+	out := make(map[MessageKey]string, len(msgs))
+	for key, message := range msgs {
+		out[key] = message
+	}
+	return out
+}
+
+// CompareTranslations returns the keys translated in base but missing
+// from target, in sorted order. An empty result means target covers base
+// completely.
+func CompareTranslations(base, target Language) []MessageKey {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	targetMsgs := translations[target]
+	var missing []MessageKey
+	for key := range translations[base] {
+		if _, ok := targetMsgs[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+	return missing
+}
+
+// MessageKeys returns every key known to any registered language, in
+// sorted order.
+func MessageKeys() []MessageKey {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	seen := make(map[MessageKey]struct{})
+	for _, msgs := range translations {
+		for key := range msgs {
+			seen[key] = struct{}{}
+		}
+	}
+	keys := make([]MessageKey, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,131 @@
+package i18n
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRegisterTranslationOverrides(t *testing.T) {
+	const key MessageKey = "test_register_key"
+	if got := Get(English, key); got != string(key) {
+		t.Fatalf("Get before registration = %q, want key fallback", got)
+	}
+	RegisterTranslation(English, key, "registered message")
+	if got := Get(English, key); got != "registered message" {
+		t.Errorf("Get = %q, want registered message", got)
+	}
+	if got := Get(Indonesian, key); got != "registered message" {
+		t.Errorf("Get falls back to English: got %q", got)
+	}
+}
+
+func TestRegisterLanguage(t *testing.T) {
+	const portuguese Language = "pt"
+	if portuguese.IsValid() {
+		t.Fatal("pt is valid before registration")
+	}
+	RegisterLanguage(portuguese, map[MessageKey]string{
+		MsgInvalidAmount: "o valor está abaixo do mínimo permitido",
+	})
+	if !portuguese.IsValid() {
+		t.Error("pt is not valid after registration")
+	}
+	if got := Get(portuguese, MsgInvalidAmount); got != "o valor está abaixo do mínimo permitido" {
+		t.Errorf("Get(pt, MsgInvalidAmount) = %q", got)
+	}
+	if got := Get(portuguese, MsgInvalidBankCode); got != Get(English, MsgInvalidBankCode) {
+		t.Errorf("untranslated key does not fall back to English: %q", got)
+	}
+
+	found := false
+	for _, lang := range SupportedLanguages() {
+		if lang == portuguese {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("SupportedLanguages() = %v, missing pt", SupportedLanguages())
+	}
+}
+
+func TestConcurrentRegistrationAndRead(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				RegisterTranslation(English, MessageKey(fmt.Sprintf("race_key_%d", i)), "v")
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				Get(Indonesian, MsgInvalidAmount)
+				English.IsValid()
+				SupportedLanguages()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGetAllReturnsCopy(t *testing.T) {
+	all := GetAll(English)
+	if len(all) == 0 {
+		t.Fatal("GetAll(English) is empty")
+	}
+	if all[MsgTimeout] != Get(English, MsgTimeout) {
+		t.Errorf("GetAll[MsgTimeout] = %q, want %q", all[MsgTimeout], Get(English, MsgTimeout))
+	}
+	all[MsgTimeout] = "mutated"
+	if Get(English, MsgTimeout) == "mutated" {
+		t.Error("mutating the GetAll result changed the registry")
+	}
+	if got, want := len(all), len(GetAll(English)); got != want {
+		t.Errorf("entry count = %d, want %d", got, want)
+	}
+}
+
+// builtinEnglishKeys snapshots the built-in English table before any test
+// registers ad-hoc keys at runtime.
+var builtinEnglishKeys = GetAll(English)
+
+func TestTranslationCompleteness(t *testing.T) {
+	var missing []MessageKey
+	for _, key := range CompareTranslations(English, Indonesian) {
+		if _, builtin := builtinEnglishKeys[key]; builtin {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) != 0 {
+		t.Errorf("Indonesian is missing translations for %v", missing)
+	}
+}
+
+func TestCompareTranslationsFindsMissing(t *testing.T) {
+	const lang = Language("xx-compare")
+	RegisterLanguage(lang, map[MessageKey]string{MsgTimeout: "xx"})
+	missing := CompareTranslations(English, lang)
+	if len(missing) != len(GetAll(English))-1 {
+		t.Errorf("missing count = %d, want every English key except MsgTimeout", len(missing))
+	}
+	for _, key := range missing {
+		if key == MsgTimeout {
+			t.Error("MsgTimeout reported missing despite being registered")
+		}
+	}
+}
+
+func TestMessageKeysSorted(t *testing.T) {
+	keys := MessageKeys()
+	if len(keys) < len(GetAll(English)) {
+		t.Errorf("MessageKeys returned %d keys, want at least the English count %d", len(keys), len(GetAll(English)))
+	}
+	for i := 1; i < len(keys); i++ {
+		if keys[i-1] >= keys[i] {
+			t.Fatalf("keys not sorted: %q before %q", keys[i-1], keys[i])
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Package flexjson decodes the loosely typed JSON GSPAY2 sends in
+// callbacks: identifiers and amounts arrive sometimes as numbers and
+// sometimes as quoted strings, and statuses as integers or string
+// integers. Each helper names the field in its error so a rejected
+// callback pinpoints the offending value.
+package flexjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Number decodes raw as a JSON number or a quoted decimal string.
+func Number(field string, raw json.RawMessage) (json.Number, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	var n json.Number
+	if err := unmarshalNumber(raw, &n); err != nil {
+		return "", fmt.Errorf("field %q: expected a number or numeric string, got %s", field, preview(raw))
+	}
+	return n, nil
+}
+
+// String decodes raw as a JSON string.
+func String(field string, raw json.RawMessage) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", fmt.Errorf("field %q: expected a string, got %s", field, preview(raw))
+	}
+	return s, nil
+}
+
+// Int decodes raw as a JSON integer or a string containing one.
+func Int(field string, raw json.RawMessage) (int, error) {
+	if len(raw) == 0 {
+		return 0, nil
+	}
+	var n json.Number
+	if err := unmarshalNumber(raw, &n); err != nil {
+		return 0, fmt.Errorf("field %q: expected an integer or integer string, got %s", field, preview(raw))
+	}
+	i, err := strconv.Atoi(n.String())
+	if err != nil {
+		return 0, fmt.Errorf("field %q: expected an integer or integer string, got %s", field, preview(raw))
+	}
+	return i, nil
+}
+
+// unmarshalNumber accepts both bare numbers and quoted numeric strings.
+func unmarshalNumber(raw json.RawMessage, n *json.Number) error {
+	s := string(raw)
+	if raw[0] == '"' {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+	}
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return err
+	}
+	*n = json.Number(s)
+	return nil
+}
+
+// preview renders the raw value for error messages, truncated so a large
+// payload cannot flood the log.
+func preview(raw json.RawMessage) string {
+	const max = 32
+	if len(raw) > max {
+		return string(raw[:max]) + "..."
+	}
+	return string(raw)
+}
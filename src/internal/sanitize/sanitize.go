@@ -0,0 +1,43 @@
+// Package sanitize masks personally identifiable information and secrets
+// before they reach log output.
+package sanitize
+
+import (
+	"regexp"
+	"strings"
+)
+
+var endpointAuthKey = regexp.MustCompile(`(/operators?/)[^/]+`)
+
+// AccountNumber masks a bank account number, keeping only the last four
+// digits, e.g. "1234567890" becomes "****7890".
+func AccountNumber(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) <= 4 {
+		return "****"
+	}
+	return "****" + s[len(s)-4:]
+}
+
+// AccountName reduces an account holder name to initials, e.g. "John Doe"
+// becomes "J*** D***".
+func AccountName(s string) string {
+	if s == "" {
+		return ""
+	}
+	words := strings.Fields(s)
+	for i, w := range words {
+		r := []rune(w)
+		words[i] = string(r[0]) + "***"
+	}
+	return strings.Join(words, " ")
+}
+
+// Endpoint redacts the operator auth key embedded in an endpoint path,
+// e.g. "/v2/integrations/operators/abc123/idr/payment" becomes
+// "/v2/integrations/operators/****/idr/payment".
+func Endpoint(s string) string {
+	return endpointAuthKey.ReplaceAllString(s, "${1}****")
+}
@@ -0,0 +1,15 @@
+// Package signature implements the GSPAY2 request signing scheme.
+//
+// The production scheme is MD5 over the concatenated request fields plus
+// the operator secret key, exposed through Generate and Verify. Pluggable
+// digests for HMAC-SHA256 deployments live in the public
+// src/signature package; this internal package stays MD5-only for the
+// default path.
+//
+// Verify compares signatures in constant time: the expected digest is
+// recomputed and compared with crypto/subtle.ConstantTimeCompare, so the
+// comparison duration does not depend on where the candidate first
+// differs. The only data-dependent branch is the length check, which
+// reveals nothing an attacker does not already know (MD5 digests are
+// always 32 hex characters).
+package signature
@@ -0,0 +1,26 @@
+package signature
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// Generate returns the lowercase hex MD5 digest of data. GSPAY2 signatures
+// are MD5 over the concatenated request fields followed by the operator
+// secret key.
+func Generate(data string) string {
+	sum := md5.Sum([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify reports whether got matches the signature computed over data. The
+// comparison is constant time so attackers cannot learn the expected
+// signature byte by byte from response timing.
+func Verify(data, got string) bool {
+	want := Generate(data)
+	if len(want) != len(got) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
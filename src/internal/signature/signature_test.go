@@ -0,0 +1,99 @@
+package signature
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	data := "31" + "50000.00" + "TXN12345" + "1" + "secret-key"
+	want := Generate(data)
+	if !Verify(data, want) {
+		t.Error("Verify rejected the matching signature")
+	}
+	if Verify(data, Generate("tampered")) {
+		t.Error("Verify accepted a wrong signature")
+	}
+	if Verify(data, want[:16]) {
+		t.Error("Verify accepted a truncated signature")
+	}
+}
+
+// timeVerify returns the fastest observed duration for iterations
+// verifications of got against data. Taking the minimum over several
+// rounds filters out scheduler noise.
+func timeVerify(data, got string, rounds, iterations int) time.Duration {
+	best := time.Duration(1<<63 - 1)
+	for r := 0; r < rounds; r++ {
+		start := time.Now()
+		for i := 0; i < iterations; i++ {
+			Verify(data, got)
+		}
+		if d := time.Since(start); d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+func TestVerifyTimingAttack(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing measurement is slow")
+	}
+	data := "31" + "50000.00" + "TXN12345" + "1" + "secret-key"
+	want := Generate(data)
+	// Flip the first and the last hex digit: an early-exiting comparison
+	// would reject the first-byte difference measurably faster.
+	firstDiff := flipHex(want, 0)
+	lastDiff := flipHex(want, len(want)-1)
+
+	const rounds, iterations = 20, 50000
+	timeVerify(data, want, 2, iterations) // warm up
+	first := timeVerify(data, firstDiff, rounds, iterations)
+	last := timeVerify(data, lastDiff, rounds, iterations)
+
+	larger, smaller := first, last
+	if smaller > larger {
+		larger, smaller = smaller, larger
+	}
+	if diff := float64(larger-smaller) / float64(larger); diff > 0.10 {
+		t.Errorf("first-byte diff %v vs last-byte diff %v: %.0f%% apart, want within 10%%",
+			first, last, diff*100)
+	}
+}
+
+// flipHex replaces the hex digit at index i with a different one.
+func flipHex(s string, i int) string {
+	replacement := byte('0')
+	if s[i] == '0' {
+		replacement = '1'
+	}
+	return s[:i] + string(replacement) + s[i+1:]
+}
+
+func TestVerifyUnicode(t *testing.T) {
+	data := "ผู้ใช้" + "50000.00" + "TXN12345" // multi-byte runes in the signed data
+	want := Generate(data)
+	if !Verify(data, want) {
+		t.Error("Verify rejected a signature over multi-byte data")
+	}
+	// A "signature" containing multi-byte runes must not panic, whatever
+	// its rune count; only byte length matters.
+	if Verify(data, strings.Repeat("ก", 8)) {
+		t.Error("Verify accepted a non-hex unicode signature")
+	}
+	if Verify(data, want[:30]+"กข") {
+		t.Error("Verify accepted a signature padded with multi-byte runes")
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	data := "31" + "50000.00" + "TXN12345" + "1" + "secret-key"
+	want := Generate(data)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(data, want)
+	}
+}
@@ -0,0 +1,85 @@
+// Package logger defines the logging interface used throughout the SDK and
+// a pair of basic implementations. Integrators plug in their own logging
+// backend by implementing Handler.
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+)
+
+// Level controls which messages a Handler emits.
+type Level int
+
+const (
+	// LevelDebug emits everything, including request/response details.
+	LevelDebug Level = iota
+	// LevelInfo emits lifecycle messages and above.
+	LevelInfo
+	// LevelWarn emits warnings and errors only.
+	LevelWarn
+	// LevelError emits errors only.
+	LevelError
+)
+
+// Handler receives log messages from the SDK. Messages carry alternating
+// key/value pairs in keysAndValues, in the style of logr.
+type Handler interface {
+	Debug(msg string, keysAndValues ...any)
+	Info(msg string, keysAndValues ...any)
+	Warn(msg string, keysAndValues ...any)
+	Error(msg string, keysAndValues ...any)
+}
+
+// NewNop returns a Handler that discards everything.
+func NewNop() Handler { return nopHandler{} }
+
+type nopHandler struct{}
+
+func (nopHandler) Debug(string, ...any) {}
+func (nopHandler) Info(string, ...any)  {}
+func (nopHandler) Warn(string, ...any)  {}
+func (nopHandler) Error(string, ...any) {}
+
+// NewStd returns a Handler writing plain text lines to w, filtered by the
+// given minimum level.
+func NewStd(w io.Writer, level Level) Handler {
+	return &stdHandler{l: log.New(w, "gspay: ", log.LstdFlags), level: level}
+}
+
+type stdHandler struct {
+	l     *log.Logger
+	level Level
+}
+
+func (h *stdHandler) log(level Level, tag, msg string, keysAndValues []any) {
+	if level < h.level {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(tag)
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+	}
+	h.l.Print(b.String())
+}
+
+func (h *stdHandler) Debug(msg string, keysAndValues ...any) {
+	h.log(LevelDebug, "DEBUG", msg, keysAndValues)
+}
+
+func (h *stdHandler) Info(msg string, keysAndValues ...any) {
+	h.log(LevelInfo, "INFO", msg, keysAndValues)
+}
+
+func (h *stdHandler) Warn(msg string, keysAndValues ...any) {
+	h.log(LevelWarn, "WARN", msg, keysAndValues)
+}
+
+func (h *stdHandler) Error(msg string, keysAndValues ...any) {
+	h.log(LevelError, "ERROR", msg, keysAndValues)
+}
@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewSlog returns a Handler that forwards SDK log calls to the given
+// slog.Handler. Levels map directly and the variadic key/value pairs are
+// converted to slog attributes by slog itself, so groups and typed attrs
+// work as usual.
+func NewSlog(h slog.Handler) Handler {
+	return &slogHandler{inner: slog.New(h)}
+}
+
+// NewSlogDefault returns a Handler backed by slog.Default.
+func NewSlogDefault() Handler {
+	return NewSlog(slog.Default().Handler())
+}
+
+type slogHandler struct {
+	inner *slog.Logger
+}
+
+func (h *slogHandler) Debug(msg string, keysAndValues ...any) {
+	h.inner.Log(context.Background(), slog.LevelDebug, msg, keysAndValues...)
+}
+
+func (h *slogHandler) Info(msg string, keysAndValues ...any) {
+	h.inner.Log(context.Background(), slog.LevelInfo, msg, keysAndValues...)
+}
+
+func (h *slogHandler) Warn(msg string, keysAndValues ...any) {
+	h.inner.Log(context.Background(), slog.LevelWarn, msg, keysAndValues...)
+}
+
+func (h *slogHandler) Error(msg string, keysAndValues ...any) {
+	h.inner.Log(context.Background(), slog.LevelError, msg, keysAndValues...)
+}
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogBridgeLevelsAndAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewSlog(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	h.Debug("debug msg", "k", "v")
+	h.Info("info msg", "transactionID", "TXN12345")
+	h.Warn("warn msg", "attempt", 2)
+	h.Error("error msg", "err", "boom")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d log lines, want 4", len(lines))
+	}
+	wantLevels := []string{"DEBUG", "INFO", "WARN", "ERROR"}
+	for i, line := range lines {
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("line %d is not JSON: %v", i, err)
+		}
+		if rec["level"] != wantLevels[i] {
+			t.Errorf("line %d level = %v, want %v", i, rec["level"], wantLevels[i])
+		}
+	}
+
+	var info map[string]any
+	if err := json.Unmarshal([]byte(lines[1]), &info); err != nil {
+		t.Fatal(err)
+	}
+	if info["transactionID"] != "TXN12345" {
+		t.Errorf("transactionID attr = %v, want TXN12345", info["transactionID"])
+	}
+}
+
+func TestSlogBridgeMasksStayMasked(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewSlog(slog.NewJSONHandler(&buf, nil))
+	// The services pass already-sanitized values; the bridge must not
+	// transform them.
+	h.Info("payout created", "accountNumber", "****7890")
+	if !strings.Contains(buf.String(), "****7890") {
+		t.Error("sanitized account number missing from slog output")
+	}
+	if strings.Contains(buf.String(), "1234567890") {
+		t.Error("raw account number appeared in slog output")
+	}
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// StructuredHandler receives fully structured log records: a level, a
+// message and a flat attribute map instead of alternating key/value
+// pairs. The context is the one active for the logged operation, so
+// handlers can pull trace or tenant information from it.
+type StructuredHandler interface {
+	Log(ctx context.Context, level Level, msg string, attrs map[string]any) error
+}
+
+// NewStructuredNop returns a StructuredHandler that discards everything.
+func NewStructuredNop() StructuredHandler { return nopStructured{} }
+
+type nopStructured struct{}
+
+func (nopStructured) Log(context.Context, Level, string, map[string]any) error { return nil }
+
+// NewStructuredJSON returns a StructuredHandler writing one JSON object
+// per record to w, with "level", "msg", "time" and the attributes as
+// top-level fields. Writes are serialized, so w does not need to be safe
+// for concurrent use.
+func NewStructuredJSON(w io.Writer) StructuredHandler {
+	return &jsonStructured{w: w}
+}
+
+type jsonStructured struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+func (h *jsonStructured) Log(_ context.Context, level Level, msg string, attrs map[string]any) error {
+	record := make(map[string]any, len(attrs)+3)
+	for k, v := range attrs {
+		record[k] = v
+	}
+	record["level"] = levelNames[level]
+	record["msg"] = msg
+	record["time"] = time.Now().UTC().Format(time.RFC3339)
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err = h.w.Write(append(line, '\n'))
+	return err
+}
+
+// FromStructured adapts a StructuredHandler to the Handler interface the
+// SDK logs through: the alternating key/value pairs are collected into an
+// attribute map. Call sites do not carry a context, so the handler
+// receives context.Background(); Log errors are dropped because Handler
+// has nowhere to report them.
+func FromStructured(h StructuredHandler) Handler {
+	return &structuredAdapter{inner: h}
+}
+
+type structuredAdapter struct {
+	inner StructuredHandler
+}
+
+func (a *structuredAdapter) log(level Level, msg string, keysAndValues []any) {
+	attrs := make(map[string]any, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		attrs[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	_ = a.inner.Log(context.Background(), level, msg, attrs)
+}
+
+func (a *structuredAdapter) Debug(msg string, keysAndValues ...any) {
+	a.log(LevelDebug, msg, keysAndValues)
+}
+
+func (a *structuredAdapter) Info(msg string, keysAndValues ...any) {
+	a.log(LevelInfo, msg, keysAndValues)
+}
+
+func (a *structuredAdapter) Warn(msg string, keysAndValues ...any) {
+	a.log(LevelWarn, msg, keysAndValues)
+}
+
+func (a *structuredAdapter) Error(msg string, keysAndValues ...any) {
+	a.log(LevelError, msg, keysAndValues)
+}
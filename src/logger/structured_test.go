@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestStructuredJSONOutput(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewStructuredJSON(&buf)
+	if err := h.Log(context.Background(), LevelInfo, "payment created", map[string]any{
+		"transactionID": "TXN12345",
+		"amount":        int64(50000),
+	}); err != nil {
+		t.Fatalf("Log: %v", err)
+	}
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", buf.String(), err)
+	}
+	if record["level"] != "info" || record["msg"] != "payment created" {
+		t.Errorf("record = %v, want level info and the message", record)
+	}
+	if record["transactionID"] != "TXN12345" || record["amount"] != float64(50000) {
+		t.Errorf("record = %v, want the attributes as top-level fields", record)
+	}
+	if _, ok := record["time"]; !ok {
+		t.Errorf("record = %v, want a time field", record)
+	}
+}
+
+func TestStructuredJSONOneLinePerRecord(t *testing.T) {
+	var buf bytes.Buffer
+	h := NewStructuredJSON(&buf)
+	h.Log(context.Background(), LevelDebug, "first", nil)
+	h.Log(context.Background(), LevelError, "second", nil)
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("output has %d lines, want 2", len(lines))
+	}
+	for _, line := range lines {
+		var record map[string]any
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Errorf("line %q is not valid JSON: %v", line, err)
+		}
+	}
+}
+
+type ctxCaptureHandler struct {
+	ctx context.Context
+}
+
+func (h *ctxCaptureHandler) Log(ctx context.Context, _ Level, _ string, _ map[string]any) error {
+	h.ctx = ctx
+	return nil
+}
+
+func TestStructuredHandlerReceivesContext(t *testing.T) {
+	type key struct{}
+	capture := &ctxCaptureHandler{}
+	ctx := context.WithValue(context.Background(), key{}, "tenant-1")
+	if err := capture.Log(ctx, LevelInfo, "probe", nil); err != nil {
+		t.Fatalf("Log: %v", err)
+	}
+	if got := capture.ctx.Value(key{}); got != "tenant-1" {
+		t.Errorf("context value = %v, want tenant-1", got)
+	}
+}
+
+func TestFromStructuredBuildsAttrMap(t *testing.T) {
+	var gotLevel Level
+	var gotMsg string
+	var gotAttrs map[string]any
+	h := FromStructured(structuredFunc(func(_ context.Context, level Level, msg string, attrs map[string]any) error {
+		gotLevel, gotMsg, gotAttrs = level, msg, attrs
+		return nil
+	}))
+	h.Warn("retrying request", "attempt", 2, "endpoint", "/probe")
+	if gotLevel != LevelWarn || gotMsg != "retrying request" {
+		t.Errorf("level/msg = %v/%q, want LevelWarn/retrying request", gotLevel, gotMsg)
+	}
+	if gotAttrs["attempt"] != 2 || gotAttrs["endpoint"] != "/probe" {
+		t.Errorf("attrs = %v, want the key/value pairs as a map", gotAttrs)
+	}
+}
+
+type structuredFunc func(ctx context.Context, level Level, msg string, attrs map[string]any) error
+
+func (f structuredFunc) Log(ctx context.Context, level Level, msg string, attrs map[string]any) error {
+	return f(ctx, level, msg, attrs)
+}
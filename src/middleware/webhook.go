@@ -0,0 +1,92 @@
+// Package middleware provides net/http middleware that verifies GSPAY2
+// webhook callbacks before they reach the application handler.
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/nikon1313/gspay-go-sdk/src/gc"
+	"github.com/nikon1313/gspay-go-sdk/src/payment"
+	"github.com/nikon1313/gspay-go-sdk/src/payout"
+)
+
+// Context keys are unexported types so applications cannot collide with
+// them.
+type (
+	paymentIDRCallbackKey  struct{}
+	paymentUSDTCallbackKey struct{}
+	payoutIDRCallbackKey   struct{}
+)
+
+// NewPaymentIDRVerifier wraps next so it only runs for IDR payment
+// callbacks whose source IP and signature verify. The verified callback
+// is stored in the request context for IDRCallbackFromContext, the body
+// is restored for next to read, and failures answer 401 without calling
+// next.
+func NewPaymentIDRVerifier(svc *payment.IDRService, next http.Handler) http.Handler {
+	return verifier(next, func(r *http.Request) (any, error) {
+		return svc.ParseAndVerifyCallbackFromRequest(r)
+	}, paymentIDRCallbackKey{})
+}
+
+// NewPaymentUSDTVerifier is NewPaymentIDRVerifier for USDT payment
+// callbacks; retrieve the callback with USDTCallbackFromContext.
+func NewPaymentUSDTVerifier(svc *payment.USDTService, next http.Handler) http.Handler {
+	return verifier(next, func(r *http.Request) (any, error) {
+		return svc.ParseAndVerifyCallbackFromRequest(r)
+	}, paymentUSDTCallbackKey{})
+}
+
+// NewPayoutIDRVerifier is NewPaymentIDRVerifier for IDR payout callbacks;
+// retrieve the callback with PayoutIDRCallbackFromContext.
+func NewPayoutIDRVerifier(svc *payout.IDRService, next http.Handler) http.Handler {
+	return verifier(next, func(r *http.Request) (any, error) {
+		return svc.ParseAndVerifyCallbackFromRequest(r)
+	}, payoutIDRCallbackKey{})
+}
+
+// verifier implements the shared buffering, verification and context
+// plumbing. parse must consume r.Body and return the verified callback.
+func verifier(next http.Handler, parse func(*http.Request) (any, error), key any) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := gc.Default.Get()
+		defer gc.Default.Put(buf)
+		if _, err := buf.ReadFrom(r.Body); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		body := buf.Bytes()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		cb, err := parse(r)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), key, cb)))
+	})
+}
+
+// IDRCallbackFromContext returns the verified IDR payment callback stored
+// by NewPaymentIDRVerifier.
+func IDRCallbackFromContext(ctx context.Context) (*payment.IDRCallback, bool) {
+	cb, ok := ctx.Value(paymentIDRCallbackKey{}).(*payment.IDRCallback)
+	return cb, ok
+}
+
+// USDTCallbackFromContext returns the verified USDT payment callback
+// stored by NewPaymentUSDTVerifier.
+func USDTCallbackFromContext(ctx context.Context) (*payment.USDTCallback, bool) {
+	cb, ok := ctx.Value(paymentUSDTCallbackKey{}).(*payment.USDTCallback)
+	return cb, ok
+}
+
+// PayoutIDRCallbackFromContext returns the verified IDR payout callback
+// stored by NewPayoutIDRVerifier.
+func PayoutIDRCallbackFromContext(ctx context.Context) (*payout.IDRCallback, bool) {
+	cb, ok := ctx.Value(payoutIDRCallbackKey{}).(*payout.IDRCallback)
+	return cb, ok
+}
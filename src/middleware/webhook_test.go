@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/payment"
+	"github.com/nikon1313/gspay-go-sdk/src/payout"
+)
+
+const (
+	testAuthKey   = "auth-key"
+	testSecretKey = "secret-key"
+)
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func paymentCallbackBody() string {
+	sig := md5Hex("31" + "50000.00" + "TXN12345" + "1" + testSecretKey)
+	return `{"idrpayment_id":31,"transaction_id":"TXN12345","amount":"50000","status":1,"signature":"` + sig + `"}`
+}
+
+func webhookRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestPaymentIDRVerifierPassesVerifiedCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := payment.NewIDRService(c)
+	body := paymentCallbackBody()
+
+	var nextBody string
+	var cb *payment.IDRCallback
+	var ok bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nextBody = string(raw)
+		cb, ok = IDRCallbackFromContext(r.Context())
+	})
+	rec := httptest.NewRecorder()
+	NewPaymentIDRVerifier(svc, next).ServeHTTP(rec, webhookRequest(body))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if nextBody != body {
+		t.Errorf("next saw body %q, want the original body restored", nextBody)
+	}
+	if !ok || cb == nil || cb.TransactionID != "TXN12345" {
+		t.Errorf("callback from context = %+v (ok=%v), want verified callback", cb, ok)
+	}
+}
+
+func TestPaymentIDRVerifierRejectsBadSignature(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := payment.NewIDRService(c)
+	body := strings.Replace(paymentCallbackBody(), "TXN12345", "TXN99999", 1)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("next called despite signature mismatch")
+	})
+	rec := httptest.NewRecorder()
+	NewPaymentIDRVerifier(svc, next).ServeHTTP(rec, webhookRequest(body))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", rec.Code)
+	}
+}
+
+func TestPaymentIDRVerifierRejectsUnlistedIP(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey,
+		client.WithCallbackIPWhitelist([]string{"10.0.0.0/8"}))
+	svc := payment.NewIDRService(c)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("next called despite unlisted source IP")
+	})
+	rec := httptest.NewRecorder()
+	// httptest.NewRequest sets RemoteAddr to 192.0.2.1, outside the list.
+	NewPaymentIDRVerifier(svc, next).ServeHTTP(rec, webhookRequest(paymentCallbackBody()))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", rec.Code)
+	}
+}
+
+func TestPayoutIDRVerifier(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := payout.NewIDRService(c)
+	sig := md5Hex("88" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey)
+	body := `{"idrpayout_id":88,"account_number":"1234567890","transaction_id":"TXN12345","amount":"50000","status":1,"signature":"` + sig + `"}`
+
+	var cb *payout.IDRCallback
+	var ok bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cb, ok = PayoutIDRCallbackFromContext(r.Context())
+	})
+	rec := httptest.NewRecorder()
+	NewPayoutIDRVerifier(svc, next).ServeHTTP(rec, webhookRequest(body))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if !ok || cb == nil || cb.IDRPayoutID.String() != "88" {
+		t.Errorf("callback from context = %+v (ok=%v), want verified payout callback", cb, ok)
+	}
+}
+
+func TestUSDTVerifier(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := payment.NewUSDTService(c)
+	sig := md5Hex("12" + "10.00" + "TXN12345" + "1" + testSecretKey)
+	body := `{"cryptopayment_id":12,"transaction_id":"TXN12345","amount":"10","status":1,"signature":"` + sig + `"}`
+
+	var cb *payment.USDTCallback
+	var ok bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cb, ok = USDTCallbackFromContext(r.Context())
+	})
+	rec := httptest.NewRecorder()
+	NewPaymentUSDTVerifier(svc, next).ServeHTTP(rec, webhookRequest(body))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if !ok || cb == nil || cb.CryptoPaymentID.String() != "12" {
+		t.Errorf("callback from context = %+v (ok=%v), want verified USDT callback", cb, ok)
+	}
+}
@@ -0,0 +1,57 @@
+package payment
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIDRCallbackTypedAccessors(t *testing.T) {
+	cb := &IDRCallback{
+		IDRPaymentID: json.Number("123"),
+		Amount:       json.Number("50000"),
+	}
+	if n, err := cb.AmountInt64(); err != nil || n != 50000 {
+		t.Errorf("AmountInt64 = %d, %v, want 50000, nil", n, err)
+	}
+	if f, err := cb.AmountFloat64(); err != nil || f != 50000 {
+		t.Errorf("AmountFloat64 = %v, %v, want 50000, nil", f, err)
+	}
+	if n, err := cb.IDRPaymentIDInt64(); err != nil || n != 123 {
+		t.Errorf("IDRPaymentIDInt64 = %d, %v, want 123, nil", n, err)
+	}
+}
+
+func TestIDRCallbackAccessorsDecimalAndZero(t *testing.T) {
+	cb := &IDRCallback{Amount: json.Number("50000.50")}
+	if f, err := cb.AmountFloat64(); err != nil || f != 50000.50 {
+		t.Errorf("AmountFloat64 = %v, %v, want 50000.50, nil", f, err)
+	}
+	if _, err := cb.AmountInt64(); err == nil {
+		t.Error("AmountInt64 on a decimal amount succeeded, want error")
+	}
+
+	zero := &IDRCallback{Amount: json.Number("0")}
+	if n, err := zero.AmountInt64(); err != nil || n != 0 {
+		t.Errorf("AmountInt64 = %d, %v, want 0, nil", n, err)
+	}
+}
+
+func TestIDRCallbackAccessorsOverflow(t *testing.T) {
+	cb := &IDRCallback{Amount: json.Number("92233720368547758080")}
+	if _, err := cb.AmountInt64(); err == nil {
+		t.Error("AmountInt64 on an overflowing amount succeeded, want error")
+	}
+}
+
+func TestIDRStatusResponseTypedAccessors(t *testing.T) {
+	status := &IDRStatusResponse{
+		IDRPaymentID: json.Number("456"),
+		Amount:       json.Number("75000"),
+	}
+	if n, err := status.AmountInt64(); err != nil || n != 75000 {
+		t.Errorf("AmountInt64 = %d, %v, want 75000, nil", n, err)
+	}
+	if n, err := status.IDRPaymentIDInt64(); err != nil || n != 456 {
+		t.Errorf("IDRPaymentIDInt64 = %d, %v, want 456, nil", n, err)
+	}
+}
@@ -0,0 +1,63 @@
+package payment
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+// BulkPaymentResult is the outcome of one request in a CreateBulk call.
+type BulkPaymentResult struct {
+	// Index is the position of the request in the input slice.
+	Index int
+	// Request is the request this result belongs to.
+	Request *IDRRequest
+	// Response is the created payment, nil when Error is set.
+	Response *IDRResponse
+	// Error is the validation or request error for this entry.
+	Error error
+}
+
+// CreateBulk creates many payments concurrently, for pre-generating
+// payment links in bulk. Every request is validated first; entries that
+// fail validation carry their error in the results and do not block the
+// valid ones. At most concurrency requests run in parallel, capped at
+// constants.MaxBulkConcurrency. Results are in input order. The returned
+// error is non-nil only when the whole operation was cut short — context
+// cancellation or the client's WithBulkTimeout — in which case the
+// results still describe every entry.
+func (s *IDRService) CreateBulk(ctx context.Context, reqs []*IDRRequest, concurrency int) ([]BulkPaymentResult, error) {
+	if concurrency < 1 || concurrency > constants.MaxBulkConcurrency {
+		concurrency = constants.MaxBulkConcurrency
+	}
+	if d := s.client.BulkTimeout(); d > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+	results := make([]BulkPaymentResult, len(reqs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		results[i] = BulkPaymentResult{Index: i, Request: req}
+		if err := req.Validate(s.client.Lang()); err != nil {
+			results[i].Error = err
+			continue
+		}
+		wg.Add(1)
+		go func(i int, req *IDRRequest) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i].Error = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+			results[i].Response, results[i].Error = s.Create(ctx, req)
+		}(i, req)
+	}
+	wg.Wait()
+	return results, ctx.Err()
+}
@@ -0,0 +1,142 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func bulkRequest(i int) *IDRRequest {
+	return &IDRRequest{Amount: 50000, TransactionID: fmt.Sprintf("TXN%05d", i), Username: "user1"}
+}
+
+func bulkEchoHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			TransactionID string `json:"transaction_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  1,
+			"transaction_id": body.TransactionID,
+			"amount":         "50000.00",
+			"payment_url":    "https://pay.example/1",
+		}))
+	}
+}
+
+func TestCreateBulkPreservesOrder(t *testing.T) {
+	c := newTestClient(t, bulkEchoHandler(t))
+	svc := NewIDRService(c)
+	reqs := make([]*IDRRequest, 8)
+	for i := range reqs {
+		reqs[i] = bulkRequest(i)
+	}
+	results, err := svc.CreateBulk(context.Background(), reqs, 3)
+	if err != nil {
+		t.Fatalf("CreateBulk: %v", err)
+	}
+	for i, res := range results {
+		if res.Index != i || res.Request != reqs[i] {
+			t.Errorf("results[%d] = index %d, request %p, want the input position", i, res.Index, res.Request)
+		}
+		if res.Error != nil {
+			t.Errorf("results[%d].Error = %v", i, res.Error)
+		} else if res.Response.TransactionID != reqs[i].TransactionID {
+			t.Errorf("results[%d].Response.TransactionID = %q, want %q", i, res.Response.TransactionID, reqs[i].TransactionID)
+		}
+	}
+}
+
+func TestCreateBulkPartialValidationFailure(t *testing.T) {
+	c := newTestClient(t, bulkEchoHandler(t))
+	svc := NewIDRService(c)
+	reqs := []*IDRRequest{
+		bulkRequest(0),
+		{Amount: 50000, TransactionID: "BAD", Username: "user1"},
+		bulkRequest(2),
+	}
+	results, err := svc.CreateBulk(context.Background(), reqs, 2)
+	if err != nil {
+		t.Fatalf("CreateBulk: %v", err)
+	}
+	if !errors.Is(results[1].Error, errors.ErrInvalidTransactionID) {
+		t.Errorf("results[1].Error = %v, want ErrInvalidTransactionID", results[1].Error)
+	}
+	for _, i := range []int{0, 2} {
+		if results[i].Error != nil {
+			t.Errorf("results[%d].Error = %v, want the valid entries to proceed", i, results[i].Error)
+		}
+	}
+}
+
+func TestCreateBulkCapsConcurrency(t *testing.T) {
+	var active, peak atomic.Int64
+	var mu sync.Mutex
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := active.Add(1)
+		mu.Lock()
+		if n > peak.Load() {
+			peak.Store(n)
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		active.Add(-1)
+		bulkEchoHandler(t)(w, r)
+	})
+	svc := NewIDRService(c)
+	reqs := make([]*IDRRequest, 12)
+	for i := range reqs {
+		reqs[i] = bulkRequest(i)
+	}
+	if _, err := svc.CreateBulk(context.Background(), reqs, 2); err != nil {
+		t.Fatalf("CreateBulk: %v", err)
+	}
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", got)
+	}
+}
+
+func TestCreateBulkContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		bulkEchoHandler(t)(w, r)
+	})
+	svc := NewIDRService(c)
+	reqs := make([]*IDRRequest, 4)
+	for i := range reqs {
+		reqs[i] = bulkRequest(i)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+		close(release)
+	}()
+	results, err := svc.CreateBulk(ctx, reqs, 1)
+	if err != context.Canceled {
+		t.Errorf("CreateBulk error = %v, want context.Canceled", err)
+	}
+	if len(results) != len(reqs) {
+		t.Fatalf("results length = %d, want %d", len(results), len(reqs))
+	}
+	var failed int
+	for _, res := range results {
+		if res.Error != nil {
+			failed++
+		}
+	}
+	if failed == 0 {
+		t.Error("no result carries the cancellation error")
+	}
+}
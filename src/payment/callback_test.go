@@ -0,0 +1,183 @@
+package payment
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/dedup"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestIDRServiceParseCallbackFromRequest(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	body := `{"idrpayment_id":123,"transaction_id":"TXN12345","amount":"50000","status":1,"signature":"abc"}`
+	r := httptest.NewRequest("POST", "/callback", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	cb, err := svc.ParseCallbackFromRequest(r)
+	if err != nil {
+		t.Fatalf("ParseCallbackFromRequest: %v", err)
+	}
+	if cb.TransactionID != "TXN12345" || cb.Status != constants.StatusSuccess {
+		t.Errorf("callback = %+v, want TXN12345/StatusSuccess", cb)
+	}
+}
+
+func TestIDRServiceParseCallbackRejectsBadRequests(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	r := httptest.NewRequest("GET", "/callback", nil)
+	r.Header.Set("Content-Type", "application/json")
+	if _, err := svc.ParseCallbackFromRequest(r); !errors.Is(err, errors.ErrInvalidHTTPMethod) {
+		t.Errorf("GET: error = %v, want ErrInvalidHTTPMethod", err)
+	}
+
+	r = httptest.NewRequest("POST", "/callback", strings.NewReader("{}"))
+	r.Header.Set("Content-Type", "text/plain")
+	if _, err := svc.ParseCallbackFromRequest(r); !errors.Is(err, errors.ErrInvalidContentType) {
+		t.Errorf("text/plain: error = %v, want ErrInvalidContentType", err)
+	}
+
+	r = httptest.NewRequest("POST", "/callback", strings.NewReader("{not json"))
+	r.Header.Set("Content-Type", "application/json")
+	if _, err := svc.ParseCallbackFromRequest(r); !errors.Is(err, errors.ErrInvalidJSON) {
+		t.Errorf("malformed body: error = %v, want ErrInvalidJSON", err)
+	}
+}
+
+func TestIDRServiceParseCallbackBodyLimit(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey, client.WithMaxCallbackBodySize(64))
+	svc := NewIDRService(c)
+
+	big := bytes.Repeat([]byte("a"), 100)
+	r := httptest.NewRequest("POST", "/callback", bytes.NewReader(big))
+	r.Header.Set("Content-Type", "application/json")
+	if _, err := svc.ParseCallbackFromRequest(r); !errors.Is(err, errors.ErrCallbackTooLarge) {
+		t.Errorf("oversized body: error = %v, want ErrCallbackTooLarge", err)
+	}
+}
+
+func TestIDRServiceParseAndVerifyCallbackFromRequest(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	sig := md5Hex("123" + "50000.00" + "TXN12345" + "1" + testSecretKey)
+	body := `{"idrpayment_id":123,"transaction_id":"TXN12345","amount":"50000","status":1,"signature":"` + sig + `"}`
+	r := httptest.NewRequest("POST", "/callback", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	cb, err := svc.ParseAndVerifyCallbackFromRequest(r)
+	if err != nil {
+		t.Fatalf("ParseAndVerifyCallbackFromRequest: %v", err)
+	}
+	if cb.TransactionID != "TXN12345" {
+		t.Errorf("TransactionID = %q, want TXN12345", cb.TransactionID)
+	}
+
+	body = strings.Replace(body, sig, "deadbeef", 1)
+	r = httptest.NewRequest("POST", "/callback", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if _, err := svc.ParseAndVerifyCallbackFromRequest(r); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Errorf("tampered signature: error = %v, want ErrSignatureMismatch", err)
+	}
+}
+
+func TestIDRCallbackValidate(t *testing.T) {
+	valid := &IDRCallback{TransactionID: "TXN12345", Amount: json.Number("50000")}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid callback: Validate = %v, want nil", err)
+	}
+
+	invalid := &IDRCallback{TransactionID: "", Amount: json.Number("-100")}
+	err := invalid.Validate(i18n.English)
+	group := errors.GetErrorGroup(err)
+	if group == nil {
+		t.Fatalf("Validate = %v, want *ErrorGroup", err)
+	}
+	fields := map[string]bool{}
+	for _, e := range group.Errors() {
+		if ve := errors.GetValidationError(e); ve != nil {
+			fields[ve.Field] = true
+		}
+	}
+	if !fields["transaction_id"] || !fields["amount"] {
+		t.Errorf("Validate fields = %v, want transaction_id and amount", fields)
+	}
+
+	malformed := &IDRCallback{TransactionID: "TXN12345", Amount: json.Number("not-a-number")}
+	if err := malformed.Validate(i18n.English); errors.GetValidationError(err) == nil {
+		t.Errorf("malformed amount: Validate = %v, want ValidationError", err)
+	}
+}
+
+func TestUSDTCallbackValidate(t *testing.T) {
+	valid := &USDTCallback{TransactionID: "TXN12345", Amount: json.Number("12.50")}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid callback: Validate = %v, want nil", err)
+	}
+
+	invalid := &USDTCallback{TransactionID: "TXN12345", Amount: json.Number("-1")}
+	if err := invalid.Validate(i18n.English); errors.GetValidationError(err) == nil {
+		t.Errorf("negative amount: Validate = %v, want ValidationError", err)
+	}
+}
+
+func TestIDRServiceVerifyCallbackRejectsInvalidStructure(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	cb := &IDRCallback{
+		IDRPaymentID:  json.Number("123"),
+		TransactionID: "",
+		Amount:        json.Number("-50000"),
+		Status:        constants.StatusSuccess,
+		Signature:     "deadbeef",
+	}
+	if err := svc.VerifyCallback(cb); errors.GetErrorGroup(err) == nil {
+		t.Errorf("VerifyCallback = %v, want *ErrorGroup before signature check", err)
+	}
+}
+
+func TestIDRServiceVerifyCallbackDeduplicates(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c, WithCallbackDeduplicator(dedup.NewLRUDeduplicator(10, 0)))
+	cb := &IDRCallback{
+		IDRPaymentID:  json.Number("123"),
+		TransactionID: "TXN12345",
+		Amount:        json.Number("50000"),
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("123" + "50000.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Fatalf("first VerifyCallback: %v", err)
+	}
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrDuplicateCallback) {
+		t.Errorf("second VerifyCallback: error = %v, want ErrDuplicateCallback", err)
+	}
+}
+
+func TestIDRServiceVerifyCallbackInvalidNotMarked(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c, WithCallbackDeduplicator(dedup.NewLRUDeduplicator(10, 0)))
+	cb := &IDRCallback{
+		IDRPaymentID:  json.Number("123"),
+		TransactionID: "TXN12345",
+		Amount:        json.Number("50000"),
+		Status:        constants.StatusSuccess,
+		Signature:     "deadbeef",
+	}
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("invalid callback: error = %v, want ErrSignatureMismatch", err)
+	}
+	cb.Signature = md5Hex("123" + "50000.00" + "TXN12345" + "1" + testSecretKey)
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Errorf("valid retry after rejection: error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"github.com/nikon1313/gspay-go-sdk/src/dedup"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// Deduplicator remembers processed callbacks. See the dedup package for
+// the built-in LRU implementation.
+type Deduplicator = dedup.Deduplicator
+
+// WithCallbackDeduplicator rejects callbacks whose transaction ID was
+// already verified, returning errors.ErrDuplicateCallback from
+// VerifyCallback. GSPAY2 resends callbacks until acknowledged, so without
+// deduplication a slow acknowledgement can credit a payment twice.
+func WithCallbackDeduplicator(d Deduplicator) IDRServiceOption {
+	return func(s *IDRService) { s.dedup = d }
+}
+
+// dedupCheck enforces the configured deduplicator after a callback passed
+// signature verification. It is a no-op when no deduplicator is set.
+func (s *IDRService) dedupCheck(transactionID string) error {
+	if s.dedup == nil {
+		return nil
+	}
+	if s.dedup.IsProcessed(transactionID) {
+		return s.client.Error(errors.ErrDuplicateCallback, transactionID)
+	}
+	s.dedup.MarkProcessed(transactionID)
+	return nil
+}
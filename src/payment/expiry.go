@@ -0,0 +1,59 @@
+package payment
+
+import (
+	"time"
+)
+
+// DefaultTimezone is the IANA zone GSPAY2 expire dates are expressed in.
+// It is used when the location passed to ExpireTime or IsExpired is nil.
+var DefaultTimezone = "Asia/Jakarta"
+
+// expireDateLayout is the format GSPAY2 uses for expire_date fields.
+const expireDateLayout = "2006-01-02 15:04:05"
+
+// parseExpireDate parses an expire_date value in the given location,
+// falling back to DefaultTimezone (then UTC) when loc is nil.
+func parseExpireDate(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		var err error
+		if loc, err = time.LoadLocation(DefaultTimezone); err != nil {
+			loc = time.UTC
+		}
+	}
+	return time.ParseInLocation(expireDateLayout, s, loc)
+}
+
+// isExpired reports whether an expire_date value lies in the past. An
+// unparseable date is treated as expired.
+func isExpired(s string, loc *time.Location) bool {
+	t, err := parseExpireDate(s, loc)
+	if err != nil {
+		return true
+	}
+	return time.Now().After(t)
+}
+
+// ExpireTime parses the response's ExpireDate in the given location. A
+// nil location means DefaultTimezone, matching the GSPAY2 servers.
+func (r *IDRResponse) ExpireTime(loc *time.Location) (time.Time, error) {
+	return parseExpireDate(r.ExpireDate, loc)
+}
+
+// IsExpired reports whether the payment's expire date has passed. An
+// unparseable ExpireDate counts as expired, so callers never redirect a
+// customer to a dead payment URL.
+func (r *IDRResponse) IsExpired(loc *time.Location) bool {
+	return isExpired(r.ExpireDate, loc)
+}
+
+// ExpireTime parses the response's ExpireDate in the given location. A
+// nil location means DefaultTimezone, matching the GSPAY2 servers.
+func (r *USDTResponse) ExpireTime(loc *time.Location) (time.Time, error) {
+	return parseExpireDate(r.ExpireDate, loc)
+}
+
+// IsExpired reports whether the payment's expire date has passed. An
+// unparseable ExpireDate counts as expired.
+func (r *USDTResponse) IsExpired(loc *time.Location) bool {
+	return isExpired(r.ExpireDate, loc)
+}
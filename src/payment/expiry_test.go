@@ -0,0 +1,58 @@
+package payment
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIDRResponseExpireTime(t *testing.T) {
+	resp := &IDRResponse{ExpireDate: "2026-01-26 15:00:00"}
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skipf("zone database unavailable: %v", err)
+	}
+	got, err := resp.ExpireTime(nil)
+	if err != nil {
+		t.Fatalf("ExpireTime: %v", err)
+	}
+	want := time.Date(2026, 1, 26, 15, 0, 0, 0, jakarta)
+	if !got.Equal(want) {
+		t.Errorf("ExpireTime = %v, want %v", got, want)
+	}
+
+	utc, err := resp.ExpireTime(time.UTC)
+	if err != nil {
+		t.Fatalf("ExpireTime(UTC): %v", err)
+	}
+	if utc.Location() != time.UTC {
+		t.Errorf("location = %v, want UTC", utc.Location())
+	}
+}
+
+func TestIDRResponseExpireTimeInvalid(t *testing.T) {
+	resp := &IDRResponse{ExpireDate: "26/01/2026"}
+	if _, err := resp.ExpireTime(time.UTC); err == nil {
+		t.Fatal("ExpireTime: want error for invalid date")
+	}
+	if !resp.IsExpired(time.UTC) {
+		t.Error("IsExpired = false for unparseable date, want true")
+	}
+}
+
+func TestIDRResponseIsExpired(t *testing.T) {
+	past := &IDRResponse{ExpireDate: time.Now().UTC().Add(-time.Hour).Format("2006-01-02 15:04:05")}
+	if !past.IsExpired(time.UTC) {
+		t.Error("past date: IsExpired = false, want true")
+	}
+	future := &IDRResponse{ExpireDate: time.Now().UTC().Add(time.Hour).Format("2006-01-02 15:04:05")}
+	if future.IsExpired(time.UTC) {
+		t.Error("future date: IsExpired = true, want false")
+	}
+}
+
+func TestUSDTResponseIsExpired(t *testing.T) {
+	past := &USDTResponse{ExpireDate: time.Now().UTC().Add(-time.Hour).Format("2006-01-02 15:04:05")}
+	if !past.IsExpired(time.UTC) {
+		t.Error("past date: IsExpired = false, want true")
+	}
+}
@@ -0,0 +1,77 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// FeeConfig describes the fee GSPAY2 charges on one payment channel.
+type FeeConfig struct {
+	// PercentageBps is the proportional fee in basis points, so 70 means
+	// 0.70% of the payment amount.
+	PercentageBps int
+	// FixedIDR is a flat fee in whole Rupiah added on top.
+	FixedIDR int64
+}
+
+// FeeTable maps payment channels to their fee configuration. Channels
+// missing from the table are estimated as fee-free.
+type FeeTable map[constants.ChannelIDR]FeeConfig
+
+// FeeEstimate is the client-side fee breakdown for a prospective payment.
+type FeeEstimate struct {
+	// BaseAmount is the payment amount the estimate was computed for.
+	BaseAmount int64
+	// FeeAmount is the estimated fee in whole Rupiah, rounded to the
+	// nearest Rupiah.
+	FeeAmount int64
+	// TotalAmount is BaseAmount plus FeeAmount.
+	TotalAmount int64
+	// FeePercentage is the proportional part of the fee in percent.
+	FeePercentage float64
+	// FeeFixed is the flat part of the fee in whole Rupiah.
+	FeeFixed int64
+	// Channel is the channel the estimate applies to.
+	Channel constants.ChannelIDR
+}
+
+// WithFeeTable configures the fee table EstimatedFee computes estimates
+// from. The table is copied, so later changes to the argument do not
+// affect the service.
+func WithFeeTable(table FeeTable) IDRServiceOption {
+	return func(s *IDRService) {
+		s.feeTable = make(FeeTable, len(table))
+		for channel, cfg := range table {
+			s.feeTable[channel] = cfg
+		}
+	}
+}
+
+// EstimatedFee returns the approximate fee for a payment of amount Rupiah
+// on the given channel. GSPAY2 does not expose a fee endpoint, so the
+// estimate is computed client-side from the table given to WithFeeTable
+// and may differ from the fee actually charged; treat it as a display
+// hint, not an authoritative quote. Channels absent from the table are
+// estimated as fee-free. The proportional part is rounded to the nearest
+// Rupiah.
+func (s *IDRService) EstimatedFee(ctx context.Context, amount int64, channel constants.ChannelIDR) (*FeeEstimate, error) {
+	if amount <= 0 {
+		return nil, errors.NewValidationError(s.client.Lang(), "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount)
+	}
+	if !constants.IsValidChannelIDR(channel) {
+		return nil, errors.NewValidationError(s.client.Lang(), "channel", i18n.MsgInvalidChannel, errors.ErrInvalidChannel)
+	}
+	cfg := s.feeTable[channel]
+	fee := (amount*int64(cfg.PercentageBps)+5000)/10000 + cfg.FixedIDR
+	return &FeeEstimate{
+		BaseAmount:    amount,
+		FeeAmount:     fee,
+		TotalAmount:   amount + fee,
+		FeePercentage: float64(cfg.PercentageBps) / 100,
+		FeeFixed:      cfg.FixedIDR,
+		Channel:       channel,
+	}, nil
+}
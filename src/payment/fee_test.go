@@ -0,0 +1,86 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func feeTestService(t *testing.T) *IDRService {
+	t.Helper()
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("EstimatedFee sent a network request")
+	})
+	return NewIDRService(c, WithFeeTable(FeeTable{
+		constants.ChannelQRIS: {PercentageBps: 70},
+		constants.ChannelDANA: {PercentageBps: 150, FixedIDR: 500},
+		constants.ChannelVA:   {FixedIDR: 4000},
+	}))
+}
+
+func TestEstimatedFeePerChannel(t *testing.T) {
+	svc := feeTestService(t)
+	cases := []struct {
+		channel           constants.ChannelIDR
+		amount, fee       int64
+		percentage        float64
+		fixedContribution int64
+	}{
+		{constants.ChannelQRIS, 100000, 700, 0.70, 0},
+		{constants.ChannelDANA, 100000, 2000, 1.50, 500},
+		{constants.ChannelVA, 100000, 4000, 0, 4000},
+	}
+	for _, tc := range cases {
+		est, err := svc.EstimatedFee(context.Background(), tc.amount, tc.channel)
+		if err != nil {
+			t.Fatalf("EstimatedFee(%s): %v", tc.channel, err)
+		}
+		if est.FeeAmount != tc.fee {
+			t.Errorf("%s: FeeAmount = %d, want %d", tc.channel, est.FeeAmount, tc.fee)
+		}
+		if est.FeePercentage != tc.percentage || est.FeeFixed != tc.fixedContribution {
+			t.Errorf("%s: percentage/fixed = %v/%d, want %v/%d",
+				tc.channel, est.FeePercentage, est.FeeFixed, tc.percentage, tc.fixedContribution)
+		}
+		if est.TotalAmount != est.BaseAmount+est.FeeAmount {
+			t.Errorf("%s: TotalAmount = %d, want base %d + fee %d",
+				tc.channel, est.TotalAmount, est.BaseAmount, est.FeeAmount)
+		}
+	}
+}
+
+func TestEstimatedFeeZeroFeeChannel(t *testing.T) {
+	svc := feeTestService(t)
+	est, err := svc.EstimatedFee(context.Background(), 50000, constants.ChannelOVO)
+	if err != nil {
+		t.Fatalf("EstimatedFee: %v", err)
+	}
+	if est.FeeAmount != 0 || est.TotalAmount != 50000 {
+		t.Errorf("estimate = %+v, want zero fee for a channel missing from the table", est)
+	}
+}
+
+func TestEstimatedFeeRoundsToNearestRupiah(t *testing.T) {
+	svc := feeTestService(t)
+	// 0.70% of 12345 is 86.415, which rounds to 86.
+	est, err := svc.EstimatedFee(context.Background(), 12345, constants.ChannelQRIS)
+	if err != nil {
+		t.Fatalf("EstimatedFee: %v", err)
+	}
+	if est.FeeAmount != 86 {
+		t.Errorf("FeeAmount = %d, want 86", est.FeeAmount)
+	}
+}
+
+func TestEstimatedFeeValidation(t *testing.T) {
+	svc := feeTestService(t)
+	if _, err := svc.EstimatedFee(context.Background(), 0, constants.ChannelQRIS); !errors.Is(err, errors.ErrInvalidAmount) {
+		t.Errorf("amount 0 error = %v, want ErrInvalidAmount", err)
+	}
+	if _, err := svc.EstimatedFee(context.Background(), 50000, "BOGUS"); !errors.Is(err, errors.ErrInvalidChannel) {
+		t.Errorf("unknown channel error = %v, want ErrInvalidChannel", err)
+	}
+}
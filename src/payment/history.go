@@ -0,0 +1,85 @@
+package payment
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// HistoryRequest selects a page of historical IDR payments. Page starts at
+// one; nil filters are omitted from the query.
+type HistoryRequest struct {
+	// Page is the one-based page number.
+	Page int
+	// PageSize is the number of items per page, at most
+	// constants.MaxHistoryPageSize.
+	PageSize int
+	// Status restricts the listing to a single status. Nil means all.
+	Status *constants.PaymentStatus
+	// From and To bound the creation time of the listed payments.
+	From *time.Time
+	To   *time.Time
+}
+
+// HistoryResponse is one page of historical IDR payments.
+type HistoryResponse struct {
+	Items      []*IDRStatusResponse `json:"items"`
+	TotalCount int                  `json:"total_count"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"page_size"`
+	HasMore    bool                 `json:"has_more"`
+}
+
+// Validate checks the pagination bounds without making a network call.
+func (r *HistoryRequest) Validate(lang i18n.Language) error {
+	g := errors.NewErrorGroup()
+	g.Lang = lang
+	if r.Page < 1 {
+		g.Add(errors.NewValidationError(lang, "page", i18n.MsgInvalidPagination))
+	}
+	if r.PageSize < 1 || r.PageSize > constants.MaxHistoryPageSize {
+		g.Add(errors.NewValidationError(lang, "page_size", i18n.MsgInvalidPagination))
+	}
+	return g.Err()
+}
+
+// query renders the request as a URL query string.
+func (r *HistoryRequest) query() string {
+	q := url.Values{}
+	q.Set("page", strconv.Itoa(r.Page))
+	q.Set("page_size", strconv.Itoa(r.PageSize))
+	if r.Status != nil {
+		q.Set("status", strconv.Itoa(int(*r.Status)))
+	}
+	if r.From != nil {
+		q.Set("from", r.From.UTC().Format(time.RFC3339))
+	}
+	if r.To != nil {
+		q.Set("to", r.To.UTC().Format(time.RFC3339))
+	}
+	return q.Encode()
+}
+
+// GetHistory lists historical IDR payments one page at a time.
+func (s *IDRService) GetHistory(ctx context.Context, req *HistoryRequest) (*HistoryResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.idr.get_history")
+	defer span.End()
+	if err := req.Validate(s.client.Lang()); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingIDRPaymentStatus),
+		"page", req.Page, "pageSize", req.PageSize)
+	endpoint := s.client.Endpoint(constants.EndpointIDRPaymentHistory) + "?" + req.query()
+	var resp HistoryResponse
+	if err := s.client.Get(ctx, endpoint, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPaymentStatusRetrieved),
+		"page", resp.Page, "items", len(resp.Items), "totalCount", resp.TotalCount)
+	return &resp, nil
+}
@@ -0,0 +1,50 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestPaymentHistoryRequestValidate(t *testing.T) {
+	if err := (&HistoryRequest{Page: 1, PageSize: 100}).Validate(i18n.English); err != nil {
+		t.Errorf("valid request: Validate = %v, want nil", err)
+	}
+	if err := (&HistoryRequest{Page: 0, PageSize: 101}).Validate(i18n.English); errors.GetErrorGroup(err) == nil {
+		t.Errorf("Validate = %v, want *ErrorGroup for both fields", err)
+	}
+}
+
+func TestIDRServiceGetHistory(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("page") != "1" || q.Get("page_size") != "10" {
+			t.Errorf("pagination query = %v, want page=1 page_size=10", q)
+		}
+		if q.Has("status") || q.Has("from") || q.Has("to") {
+			t.Errorf("query = %v, want no optional filters", q)
+		}
+		w.Write(envelope(t, map[string]any{
+			"items": []map[string]any{
+				{"idrpayment_id": 123, "transaction_id": "TXN12345", "amount": "50000.00", "status": 1},
+			},
+			"total_count": 1,
+			"page":        1,
+			"page_size":   10,
+		}))
+	})
+	svc := NewIDRService(c)
+	resp, err := svc.GetHistory(context.Background(), &HistoryRequest{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].TransactionID != "TXN12345" {
+		t.Errorf("Items = %+v, want one item TXN12345", resp.Items)
+	}
+	if resp.HasMore {
+		t.Error("HasMore = true, want false")
+	}
+}
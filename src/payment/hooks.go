@@ -0,0 +1,47 @@
+package payment
+
+import "context"
+
+// Hooks bundles the lifecycle callbacks an IDRService fires so operators
+// can attach side effects — audit logs, database writes, metrics —
+// without wrapping every SDK call. Hooks run synchronously before the
+// method returns and are not recovered: a panicking hook propagates to
+// the caller. Nil fields are no-ops.
+type Hooks struct {
+	// OnPaymentCreated fires after Create or CreateWithOptions succeeds.
+	OnPaymentCreated func(context.Context, *IDRResponse)
+	// OnCallbackVerified fires after VerifyCallbackWithIP accepts a callback.
+	OnCallbackVerified func(context.Context, *IDRCallback)
+	// OnCallbackFailed fires when VerifyCallbackWithIP rejects a callback.
+	OnCallbackFailed func(context.Context, error)
+	// OnStatusRetrieved fires after GetStatus succeeds.
+	OnStatusRetrieved func(context.Context, *IDRStatusResponse)
+}
+
+// IDRServiceOption configures an IDRService during construction.
+type IDRServiceOption func(*IDRService)
+
+// WithHooks attaches lifecycle hooks to an IDRService.
+func WithHooks(h Hooks) IDRServiceOption {
+	return func(s *IDRService) { s.hooks = h }
+}
+
+// USDTHooks is the USDT counterpart of Hooks.
+type USDTHooks struct {
+	// OnPaymentCreated fires after Create succeeds.
+	OnPaymentCreated func(context.Context, *USDTResponse)
+	// OnCallbackVerified fires after VerifyCallbackWithIP accepts a callback.
+	OnCallbackVerified func(context.Context, *USDTCallback)
+	// OnCallbackFailed fires when VerifyCallbackWithIP rejects a callback.
+	OnCallbackFailed func(context.Context, error)
+	// OnStatusRetrieved fires after GetStatus succeeds.
+	OnStatusRetrieved func(context.Context, *USDTStatusResponse)
+}
+
+// USDTServiceOption configures a USDTService during construction.
+type USDTServiceOption func(*USDTService)
+
+// WithUSDTHooks attaches lifecycle hooks to a USDTService.
+func WithUSDTHooks(h USDTHooks) USDTServiceOption {
+	return func(s *USDTService) { s.hooks = h }
+}
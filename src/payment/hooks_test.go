@@ -0,0 +1,97 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+func TestIDRServiceHooksOnPaymentCreated(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  123,
+			"transaction_id": "TXN12345",
+		}))
+	})
+	var created *IDRResponse
+	svc := NewIDRService(c, WithHooks(Hooks{
+		OnPaymentCreated: func(_ context.Context, resp *IDRResponse) { created = resp },
+	}))
+	resp, err := svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created != resp {
+		t.Errorf("OnPaymentCreated got %v, want %v", created, resp)
+	}
+}
+
+func TestIDRServiceHooksOnCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey,
+		client.WithCallbackIPWhitelist([]string{"10.0.0.1"}))
+	var verified *IDRCallback
+	var failed error
+	svc := NewIDRService(c, WithHooks(Hooks{
+		OnCallbackVerified: func(_ context.Context, cb *IDRCallback) { verified = cb },
+		OnCallbackFailed:   func(_ context.Context, err error) { failed = err },
+	}))
+	cb := &IDRCallback{
+		IDRPaymentID:  "123",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("123" + "50000.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.1:39000"); err != nil {
+		t.Fatalf("VerifyCallbackWithIP: %v", err)
+	}
+	if verified != cb {
+		t.Errorf("OnCallbackVerified got %v, want %v", verified, cb)
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.2:39000"); err == nil {
+		t.Fatal("VerifyCallbackWithIP: want error for non-whitelisted IP")
+	}
+	if failed == nil {
+		t.Error("OnCallbackFailed was not invoked")
+	}
+}
+
+func TestIDRServiceHooksNilAreNoOps(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{"idrpayment_id": 123}))
+	})
+	svc := NewIDRService(c, WithHooks(Hooks{}))
+	if _, err := svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	}); err != nil {
+		t.Fatalf("Create with empty hooks: %v", err)
+	}
+}
+
+func TestIDRServiceHooksPanicPropagates(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{"idrpayment_id": 123}))
+	})
+	svc := NewIDRService(c, WithHooks(Hooks{
+		OnPaymentCreated: func(context.Context, *IDRResponse) { panic("hook boom") },
+	}))
+	defer func() {
+		if recover() == nil {
+			t.Error("panic in hook did not propagate")
+		}
+	}()
+	svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+}
@@ -0,0 +1,443 @@
+// Package payment implements the GSPAY2 payment (pay-in) services for the
+// supported currencies.
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/helper/amount"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/internal/flexjson"
+)
+
+// IDRService creates and tracks Indonesian Rupiah payments.
+type IDRService struct {
+	client   client.Doer
+	hooks    Hooks
+	dedup    Deduplicator
+	feeTable FeeTable
+}
+
+// NewIDRService returns an IDRService backed by the given client.
+func NewIDRService(c client.Doer, opts ...IDRServiceOption) *IDRService {
+	s := &IDRService{client: c}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// IDRRequest describes a new IDR payment.
+type IDRRequest struct {
+	// Amount is the payment amount in whole Rupiah.
+	Amount int64
+	// TransactionID is the operator's unique reference, 5–20 characters.
+	TransactionID string
+	// Username identifies the paying end user on the operator's side.
+	Username string
+	// Channel optionally pins the payment to a specific channel such as
+	// QRIS. Unless StrictChannelValidation is set, unknown channels are
+	// ignored and GSPAY2 presents its hosted channel selection page.
+	Channel string
+	// StrictChannelValidation rejects unrecognized channels during Validate
+	// instead of silently ignoring them.
+	StrictChannelValidation bool
+	// ChannelRequired rejects requests without a channel during Validate.
+	ChannelRequired bool
+}
+
+// IDRResponse is the payload returned when an IDR payment is created.
+type IDRResponse struct {
+	IDRPaymentID  json.Number `json:"idrpayment_id"`
+	TransactionID string      `json:"transaction_id"`
+	Amount        string      `json:"amount"`
+	PaymentURL    string      `json:"payment_url"`
+	QR            string      `json:"qr"`
+	ExpireDate    string      `json:"expire_date"`
+	// WasIdempotent is true when the response was replayed from an earlier
+	// request carrying the same idempotency key.
+	WasIdempotent bool `json:"-"`
+}
+
+// IDRStatusResponse is the payload returned by GetStatus.
+type IDRStatusResponse struct {
+	IDRPaymentID  json.Number             `json:"idrpayment_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Completed     bool                    `json:"completed"`
+	Success       bool                    `json:"success"`
+	Remark        string                  `json:"remark"`
+	Signature     string                  `json:"signature"`
+}
+
+// IDRCallback is the JSON body GSPAY2 posts to the operator's callback URL
+// when a payment reaches a final state.
+type IDRCallback struct {
+	IDRPaymentID  json.Number             `json:"idrpayment_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Signature     string                  `json:"signature"`
+}
+
+// UnmarshalJSON decodes the callback tolerantly: GSPAY2 deployments vary
+// in whether identifiers and amounts arrive as JSON numbers or quoted
+// strings, and whether the status is an integer or a string integer. A
+// value of the wrong type is rejected with an error naming the field.
+func (cb *IDRCallback) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var err error
+	if cb.IDRPaymentID, err = flexjson.Number("idrpayment_id", fields["idrpayment_id"]); err != nil {
+		return err
+	}
+	if cb.TransactionID, err = flexjson.String("transaction_id", fields["transaction_id"]); err != nil {
+		return err
+	}
+	if cb.Amount, err = flexjson.Number("amount", fields["amount"]); err != nil {
+		return err
+	}
+	status, err := flexjson.Int("status", fields["status"])
+	if err != nil {
+		return err
+	}
+	cb.Status = constants.PaymentStatus(status)
+	cb.Signature, err = flexjson.String("signature", fields["signature"])
+	return err
+}
+
+// Validate checks the callback fields structurally, without touching
+// cryptography: the transaction ID must be within length bounds and the
+// amount must be a non-negative decimal. It returns a
+// *errors.ValidationError or an *errors.ErrorGroup.
+func (cb *IDRCallback) Validate(lang i18n.Language) error {
+	g := errors.NewErrorGroup()
+	g.Lang = lang
+	if l := len(cb.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		g.Add(errors.NewValidationError(lang, "transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if f, err := strconv.ParseFloat(cb.Amount.String(), 64); err != nil {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat, errors.ErrInvalidAmount))
+	} else if f < 0 {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	return g.Err()
+}
+
+type idrCreateRequest struct {
+	Amount        int64             `json:"amount"`
+	TransactionID string            `json:"transaction_id"`
+	Username      string            `json:"username"`
+	Channel       string            `json:"channel,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	NotifyURL     string            `json:"notify_url,omitempty"`
+}
+
+// Validate checks the request fields without making a network call. It
+// returns a *errors.MultiValidationError listing every invalid field, or
+// nil when the request is valid.
+func (r *IDRRequest) Validate(lang i18n.Language) *errors.MultiValidationError {
+	m := errors.NewMultiValidationError(lang)
+	if l := len(r.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		m.Add(errors.NewValidationError(lang, "transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if r.Amount < constants.MinAmountIDR {
+		m.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	if r.Channel == "" {
+		if r.ChannelRequired {
+			m.Add(errors.NewValidationError(lang, "channel", i18n.MsgRequiredField))
+		}
+	} else if r.StrictChannelValidation && !constants.IsValidChannelIDR(constants.ChannelIDR(strings.ToUpper(r.Channel))) {
+		m.Add(errors.NewValidationError(lang, "channel", i18n.MsgInvalidChannel, errors.ErrInvalidChannel))
+	}
+	return m.Err()
+}
+
+// idempotencyReplayedHeader marks a response replayed from an earlier
+// request with the same idempotency key.
+const idempotencyReplayedHeader = "X-Idempotency-Replayed"
+
+// IDRCreateOption customizes a single CreateWithOptions call.
+type IDRCreateOption func(*idrCreateOptions)
+
+type idrCreateOptions struct {
+	idempotencyKey    string
+	hasIdempotencyKey bool
+	metadata          map[string]string
+	notifyURL         string
+}
+
+// WithIdempotencyKey sends the key as an Idempotency-Key header so GSPAY2
+// replays the original response instead of creating a duplicate payment.
+// Keys must be non-empty and at most 64 characters.
+func WithIdempotencyKey(key string) IDRCreateOption {
+	return func(o *idrCreateOptions) {
+		o.idempotencyKey = key
+		o.hasIdempotencyKey = true
+	}
+}
+
+// WithMetadata attaches an operator-defined key-value pair to the payment.
+func WithMetadata(key, value string) IDRCreateOption {
+	return func(o *idrCreateOptions) {
+		if o.metadata == nil {
+			o.metadata = make(map[string]string)
+		}
+		o.metadata[key] = value
+	}
+}
+
+// WithNotifyURL overrides the operator's configured callback URL for this
+// payment only.
+func WithNotifyURL(url string) IDRCreateOption {
+	return func(o *idrCreateOptions) { o.notifyURL = url }
+}
+
+// Create submits a new IDR payment. The returned response carries the
+// hosted payment URL (and QR string for QRIS) to present to the end user.
+func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
+	return s.CreateWithOptions(ctx, req)
+}
+
+// CreateWithOptions behaves like Create with per-call options such as an
+// idempotency key, metadata or a callback URL override.
+func (s *IDRService) CreateWithOptions(ctx context.Context, req *IDRRequest, opts ...IDRCreateOption) (*IDRResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.idr.create")
+	defer span.End()
+	if err := req.Validate(s.client.Lang()); err != nil {
+		return nil, err
+	}
+	var options idrCreateOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.hasIdempotencyKey {
+		if l := len(options.idempotencyKey); l == 0 || l > constants.MaxIdempotencyKeyLength {
+			return nil, errors.NewValidationError(s.client.Lang(), "idempotency_key", i18n.MsgInvalidIdempotency)
+		}
+	}
+	apiReq := &idrCreateRequest{
+		Amount:        req.Amount,
+		TransactionID: req.TransactionID,
+		Username:      req.Username,
+		Metadata:      options.metadata,
+		NotifyURL:     options.notifyURL,
+	}
+	upperChannel := constants.ChannelIDR(strings.ToUpper(req.Channel))
+	if constants.IsValidChannelIDR(upperChannel) {
+		apiReq.Channel = string(upperChannel)
+	}
+	var headers http.Header
+	if options.hasIdempotencyKey {
+		headers = http.Header{"Idempotency-Key": []string{options.idempotencyKey}}
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingIDRPayment),
+		"transactionID", req.TransactionID,
+		"amount", req.Amount,
+		"username", s.client.LogAccountName(req.Username),
+		"channel", apiReq.Channel)
+	endpoint := s.client.Endpoint(constants.EndpointIDRCreate)
+	var resp IDRResponse
+	envelope, err := s.client.PostWithHeaders(ctx, endpoint, apiReq, &resp, headers)
+	if err != nil {
+		return nil, err
+	}
+	resp.WasIdempotent = envelope.Header.Get(idempotencyReplayedHeader) == "true"
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPaymentCreated),
+		"transactionID", resp.TransactionID,
+		"paymentID", resp.IDRPaymentID.String())
+	if s.hooks.OnPaymentCreated != nil {
+		s.hooks.OnPaymentCreated(ctx, &resp)
+	}
+	return &resp, nil
+}
+
+// GetStatus fetches the current status of a payment by the operator's
+// transaction ID.
+func (s *IDRService) GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error) {
+	return s.getStatus(ctx, "gspay.payment.idr.get_status", constants.EndpointIDRStatus,
+		"transaction_id="+url.QueryEscape(transactionID), "transactionID", transactionID)
+}
+
+// GetStatusByPaymentID fetches the current status of a payment by GSPAY2's
+// own idrpayment_id, for cases where the operator transaction ID has been
+// lost or corrupted.
+func (s *IDRService) GetStatusByPaymentID(ctx context.Context, paymentID string) (*IDRStatusResponse, error) {
+	return s.getStatus(ctx, "gspay.payment.idr.get_status_by_id", constants.EndpointIDRStatusByID,
+		"idrpayment_id="+url.QueryEscape(paymentID), "paymentID", paymentID)
+}
+
+// getStatus performs the status fetch shared by GetStatus and
+// GetStatusByPaymentID. rawQuery must already be URL-encoded.
+func (s *IDRService) getStatus(ctx context.Context, spanName, endpointName, rawQuery, logKey, logValue string) (*IDRStatusResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, spanName)
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingIDRPaymentStatus),
+		logKey, logValue)
+	endpoint := s.client.Endpoint(endpointName) + "?" + rawQuery
+	var status IDRStatusResponse
+	if err := s.client.Get(ctx, endpoint, &status); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPaymentStatusRetrieved),
+		"transactionID", status.TransactionID,
+		"status", status.Status.String())
+	if s.hooks.OnStatusRetrieved != nil {
+		s.hooks.OnStatusRetrieved(ctx, &status)
+	}
+	return &status, nil
+}
+
+// VerifySignature checks a payment signature built from the raw field
+// values: MD5(idrpayment_id + amount + transaction_id + status +
+// secret_key), where amount is normalized to two decimal places.
+func (s *IDRService) VerifySignature(paymentID, amountStr, transactionID string, status constants.PaymentStatus, signature string) error {
+	if paymentID == "" || amountStr == "" || transactionID == "" || signature == "" {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRCallbackSignatureFailed),
+			"transactionID", transactionID, "reason", "missing field")
+		return s.client.Error(errors.ErrMissingCallbackField)
+	}
+	formatted, err := amount.ParseAmountStrict(amountStr, s.client.Lang())
+	if err != nil {
+		return err
+	}
+	if !s.client.VerifySignature(signature, paymentID, formatted, transactionID, strconv.Itoa(int(status))) {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRCallbackSignatureFailed),
+			"transactionID", transactionID, "paymentID", paymentID)
+		return s.client.Error(errors.ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// VerifyStatusSignature checks the signature on a GetStatus response.
+func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse) error {
+	return s.VerifySignature(
+		status.IDRPaymentID.String(),
+		status.Amount.String(),
+		status.TransactionID,
+		status.Status,
+		status.Signature,
+	)
+}
+
+// VerifySignatureFromStatus verifies the signature on a GetStatus
+// response after cross-checking the fields against each other: a success
+// status must be marked completed, and the Success flag must agree with
+// the status. Contradictory fields fail with
+// errors.ErrInconsistentCallback before any cryptography runs.
+func (s *IDRService) VerifySignatureFromStatus(status *IDRStatusResponse) error {
+	if status.Status.IsSuccess() != status.Success {
+		return s.client.Error(errors.ErrInconsistentCallback,
+			fmt.Sprintf("status %s with success=%t", status.Status, status.Success))
+	}
+	if status.Status.IsSuccess() && !status.Completed {
+		return s.client.Error(errors.ErrInconsistentCallback, "success status without completed")
+	}
+	return s.VerifyStatusSignature(status)
+}
+
+// VerifySignatureFromCallback verifies a callback signature after
+// checking that the status is one of the defined values; undefined
+// statuses fail with errors.ErrInconsistentCallback.
+func (s *IDRService) VerifySignatureFromCallback(cb *IDRCallback) error {
+	if _, ok := constants.ParsePaymentStatus(int(cb.Status)); !ok {
+		return s.client.Error(errors.ErrInconsistentCallback,
+			fmt.Sprintf("undefined status %d", int(cb.Status)))
+	}
+	return s.VerifySignature(
+		cb.IDRPaymentID.String(),
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Status,
+		cb.Signature,
+	)
+}
+
+// VerifyCallback validates the callback structurally and then checks its
+// signature. Callers that know the source address should prefer
+// VerifyCallbackWithIP.
+func (s *IDRService) VerifyCallback(cb *IDRCallback) error {
+	if err := cb.Validate(s.client.Lang()); err != nil {
+		return err
+	}
+	if err := s.VerifySignature(
+		cb.IDRPaymentID.String(),
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Status,
+		cb.Signature,
+	); err != nil {
+		return err
+	}
+	return s.dedupCheck(cb.TransactionID)
+}
+
+// VerifyCallbackWithIP checks the callback source address against the
+// configured whitelist and then verifies the callback signature.
+func (s *IDRService) VerifyCallbackWithIP(cb *IDRCallback, remoteAddr string) error {
+	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRCallback),
+		"transactionID", cb.TransactionID,
+		"paymentID", cb.IDRPaymentID.String(),
+		"sourceIP", remoteAddr)
+	if err := s.client.VerifyCallbackIP(remoteAddr); err != nil {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRCallbackIPFailed),
+			"transactionID", cb.TransactionID,
+			"sourceIP", remoteAddr)
+		if s.hooks.OnCallbackFailed != nil {
+			s.hooks.OnCallbackFailed(context.Background(), err)
+		}
+		return err
+	}
+	if err := s.VerifyCallback(cb); err != nil {
+		if s.hooks.OnCallbackFailed != nil {
+			s.hooks.OnCallbackFailed(context.Background(), err)
+		}
+		return err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRCallbackVerified),
+		"transactionID", cb.TransactionID,
+		"paymentID", cb.IDRPaymentID.String())
+	if s.hooks.OnCallbackVerified != nil {
+		s.hooks.OnCallbackVerified(context.Background(), cb)
+	}
+	return nil
+}
+
+// ParseCallbackFromRequest decodes a IDR payment callback from an incoming
+// webhook request. It enforces the POST method, the application/json
+// content type and the client's maximum callback body size, and returns
+// the decoded callback without verifying its signature.
+func (s *IDRService) ParseCallbackFromRequest(r *http.Request) (*IDRCallback, error) {
+	var cb IDRCallback
+	if err := s.client.ParseCallbackBody(r, &cb); err != nil {
+		return nil, err
+	}
+	return &cb, nil
+}
+
+// ParseAndVerifyCallbackFromRequest decodes a IDR payment callback and then
+// verifies its source IP and signature. It is the recommended entry point
+// for webhook handlers.
+func (s *IDRService) ParseAndVerifyCallbackFromRequest(r *http.Request) (*IDRCallback, error) {
+	cb, err := s.ParseCallbackFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.VerifyCallbackWithIP(cb, r.RemoteAddr); err != nil {
+		return nil, err
+	}
+	return cb, nil
+}
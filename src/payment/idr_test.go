@@ -0,0 +1,341 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestIDRServiceCreateValidation(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	_, err := svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "abc",
+		Username:      "user1",
+	})
+	if ve := errors.GetValidationError(err); ve == nil || ve.Field != "transaction_id" {
+		t.Errorf("short transaction ID: error = %v, want ValidationError on transaction_id", err)
+	}
+
+	_, err = svc.Create(context.Background(), &IDRRequest{
+		Amount:        500,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if ve := errors.GetValidationError(err); ve == nil || ve.Field != "amount" {
+		t.Errorf("low amount: error = %v, want ValidationError on amount", err)
+	}
+}
+
+func TestIDRRequestValidate(t *testing.T) {
+	valid := &IDRRequest{Amount: 50000, TransactionID: "TXN12345", Username: "user1"}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid request: Validate = %v, want nil", err)
+	}
+
+	invalid := &IDRRequest{Amount: 500, TransactionID: "abc"}
+	mve := invalid.Validate(i18n.English)
+	if mve == nil {
+		t.Fatal("Validate = nil, want *MultiValidationError")
+	}
+	if !mve.HasField("transaction_id") || !mve.HasField("amount") {
+		t.Errorf("Validate fields = %v, want transaction_id and amount", mve.Fields())
+	}
+}
+
+func TestIDRServiceCreate(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  123,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"payment_url":    "https://pay.example/p/123",
+			"expire_date":    "2026-01-26 15:00:00",
+		}))
+	})
+	svc := NewIDRService(c)
+	resp, err := svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+		Channel:       "qris",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.IDRPaymentID.String() != "123" {
+		t.Errorf("IDRPaymentID = %q, want 123", resp.IDRPaymentID)
+	}
+	if resp.PaymentURL == "" {
+		t.Error("PaymentURL is empty")
+	}
+}
+
+func TestIDRServiceCreateWithOptions(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Idempotency-Key"); got != "order-42" {
+			t.Errorf("Idempotency-Key = %q, want order-42", got)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		meta, _ := body["metadata"].(map[string]any)
+		if meta["player_id"] != "p-7" {
+			t.Errorf("metadata = %v, want player_id=p-7", body["metadata"])
+		}
+		if body["notify_url"] != "https://operator.example/hook" {
+			t.Errorf("notify_url = %v", body["notify_url"])
+		}
+		w.Header().Set("X-Idempotency-Replayed", "true")
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  123,
+			"transaction_id": "TXN12345",
+		}))
+	})
+	svc := NewIDRService(c)
+	resp, err := svc.CreateWithOptions(context.Background(),
+		&IDRRequest{Amount: 50000, TransactionID: "TXN12345", Username: "user1"},
+		WithIdempotencyKey("order-42"),
+		WithMetadata("player_id", "p-7"),
+		WithNotifyURL("https://operator.example/hook"))
+	if err != nil {
+		t.Fatalf("CreateWithOptions: %v", err)
+	}
+	if !resp.WasIdempotent {
+		t.Error("WasIdempotent = false, want true")
+	}
+}
+
+func TestIDRServiceCreateWithOptionsInvalidKey(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	req := &IDRRequest{Amount: 50000, TransactionID: "TXN12345", Username: "user1"}
+
+	for _, key := range []string{"", strings.Repeat("k", constants.MaxIdempotencyKeyLength+1)} {
+		_, err := svc.CreateWithOptions(context.Background(), req, WithIdempotencyKey(key))
+		if ve := errors.GetValidationError(err); ve == nil || ve.Field != "idempotency_key" {
+			t.Errorf("key %q: error = %v, want ValidationError on idempotency_key", key, err)
+		}
+	}
+}
+
+func TestIDRServiceVerifyCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	cb := &IDRCallback{
+		IDRPaymentID:  "123",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("123" + "50000.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Fatalf("VerifyCallback: %v", err)
+	}
+
+	cb.Signature = md5Hex("bad")
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+}
+
+func TestIDRServiceVerifyCallbackWithIP(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey,
+		client.WithCallbackIPWhitelist([]string{"10.0.0.1"}))
+	svc := NewIDRService(c)
+	cb := &IDRCallback{
+		IDRPaymentID:  "123",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("123" + "50000.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.1:39000"); err != nil {
+		t.Fatalf("whitelisted IP: %v", err)
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.2:39000"); !errors.Is(err, errors.ErrUnauthorizedIP) {
+		t.Fatalf("error = %v, want ErrUnauthorizedIP", err)
+	}
+}
+
+func TestIDRServiceGetStatusByPaymentID(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("idrpayment_id"); got != "123" {
+			t.Errorf("idrpayment_id = %q, want 123", got)
+		}
+		if got := r.URL.Query().Get("transaction_id"); got != "" {
+			t.Errorf("transaction_id = %q, want unset", got)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  123,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         1,
+		}))
+	})
+	svc := NewIDRService(c)
+	status, err := svc.GetStatusByPaymentID(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("GetStatusByPaymentID: %v", err)
+	}
+	if status.TransactionID != "TXN12345" {
+		t.Errorf("TransactionID = %q, want TXN12345", status.TransactionID)
+	}
+}
+
+func TestIDRServiceCreateLogsSanitizedUsername(t *testing.T) {
+	ml := &mockLogger{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  123,
+			"transaction_id": "TXN12345",
+			"amount":         "50000",
+		}))
+	}
+	c := newTestClient(t, handler, client.WithLogger(ml))
+	svc := NewIDRService(c)
+	_, err := svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "John Doe",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !ml.hasKV("username", "J*** D***") {
+		t.Error("Create log does not carry the masked username")
+	}
+	if ml.hasKV("username", "John Doe") {
+		t.Error("Create log leaks the raw username")
+	}
+}
+
+func TestIDRServiceCreateLogsRawUsernameInDebug(t *testing.T) {
+	ml := &mockLogger{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  123,
+			"transaction_id": "TXN12345",
+			"amount":         "50000",
+		}))
+	}
+	c := newTestClient(t, handler, client.WithLogger(ml), client.WithDebug(true))
+	svc := NewIDRService(c)
+	if _, err := svc.Create(context.Background(), &IDRRequest{
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "John Doe",
+	}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !ml.hasKV("username", "John Doe") {
+		t.Error("debug mode did not log the raw username")
+	}
+}
+
+func TestIDRRequestChannelValidation(t *testing.T) {
+	base := IDRRequest{Amount: 50000, TransactionID: "TXN12345", Username: "user1"}
+
+	valid := base
+	valid.Channel = "qris"
+	valid.StrictChannelValidation = true
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid channel: Validate = %v, want nil", err)
+	}
+
+	invalid := base
+	invalid.Channel = "GOPAY"
+	invalid.StrictChannelValidation = true
+	mve := invalid.Validate(i18n.English)
+	if mve == nil || !mve.HasField("channel") {
+		t.Errorf("invalid channel: Validate = %v, want ValidationError on channel", mve)
+	}
+	if !errors.Is(mve.Err(), errors.ErrInvalidChannel) {
+		t.Errorf("invalid channel: error = %v, want ErrInvalidChannel", mve)
+	}
+
+	required := base
+	required.ChannelRequired = true
+	if mve := required.Validate(i18n.English); mve == nil || !mve.HasField("channel") {
+		t.Errorf("missing required channel: Validate = %v, want ValidationError on channel", mve)
+	}
+
+	optional := base
+	if err := optional.Validate(i18n.English); err != nil {
+		t.Errorf("empty optional channel: Validate = %v, want nil", err)
+	}
+
+	lenient := base
+	lenient.Channel = "GOPAY"
+	if err := lenient.Validate(i18n.English); err != nil {
+		t.Errorf("unknown channel without strict validation: Validate = %v, want nil", err)
+	}
+}
+
+func TestIDRServiceVerifySignatureFromStatus(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	status := &IDRStatusResponse{
+		IDRPaymentID:  "31",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Completed:     true,
+		Success:       true,
+		Signature:     md5Hex("31" + "50000.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifySignatureFromStatus(status); err != nil {
+		t.Fatalf("VerifySignatureFromStatus: %v", err)
+	}
+
+	status.Completed = false
+	if err := svc.VerifySignatureFromStatus(status); !errors.Is(err, errors.ErrInconsistentCallback) {
+		t.Errorf("success without completed: error = %v, want ErrInconsistentCallback", err)
+	}
+
+	status.Completed = true
+	status.Success = false
+	if err := svc.VerifySignatureFromStatus(status); !errors.Is(err, errors.ErrInconsistentCallback) {
+		t.Errorf("success status with success=false: error = %v, want ErrInconsistentCallback", err)
+	}
+
+	status.Status = constants.StatusPending
+	status.Success = true
+	status.Completed = false
+	if err := svc.VerifySignatureFromStatus(status); !errors.Is(err, errors.ErrInconsistentCallback) {
+		t.Errorf("pending status with success=true: error = %v, want ErrInconsistentCallback", err)
+	}
+}
+
+func TestIDRServiceVerifySignatureFromCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	cb := &IDRCallback{
+		IDRPaymentID:  "31",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("31" + "50000.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifySignatureFromCallback(cb); err != nil {
+		t.Fatalf("VerifySignatureFromCallback: %v", err)
+	}
+
+	cb.Status = constants.PaymentStatus(42)
+	if err := svc.VerifySignatureFromCallback(cb); !errors.Is(err, errors.ErrInconsistentCallback) {
+		t.Errorf("undefined status: error = %v, want ErrInconsistentCallback", err)
+	}
+}
@@ -0,0 +1,57 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+// IDRServicer captures the exported methods of IDRService so applications
+// can substitute a fake in their own tests.
+type IDRServicer interface {
+	Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error)
+	CreateWithOptions(ctx context.Context, req *IDRRequest, opts ...IDRCreateOption) (*IDRResponse, error)
+	GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error)
+	GetStatusByPaymentID(ctx context.Context, paymentID string) (*IDRStatusResponse, error)
+	GetStatusBatch(ctx context.Context, transactionIDs []string, concurrency int) (map[string]*IDRStatusResponse, map[string]error)
+	WaitForCompletion(ctx context.Context, transactionID string, interval time.Duration) (*IDRStatusResponse, error)
+	VerifySignature(paymentID, amountStr, transactionID string, status constants.PaymentStatus, signature string) error
+	VerifyStatusSignature(status *IDRStatusResponse) error
+	VerifySignatureFromStatus(status *IDRStatusResponse) error
+	VerifySignatureFromCallback(cb *IDRCallback) error
+	VerifyCallback(cb *IDRCallback) error
+	VerifyCallbackWithIP(cb *IDRCallback, remoteAddr string) error
+	ParseCallbackFromRequest(r *http.Request) (*IDRCallback, error)
+	ParseAndVerifyCallbackFromRequest(r *http.Request) (*IDRCallback, error)
+}
+
+// USDTServicer captures the exported methods of USDTService.
+type USDTServicer interface {
+	Create(ctx context.Context, req *USDTRequest) (*USDTResponse, error)
+	GetStatus(ctx context.Context, transactionID string) (*USDTStatusResponse, error)
+	VerifySignature(cryptoPaymentID, amountStr, transactionID string, status constants.PaymentStatus, signature string) error
+	VerifyStatusSignature(status *USDTStatusResponse) error
+	VerifyCallback(cb *USDTCallback) error
+	VerifyCallbackWithIP(cb *USDTCallback, remoteAddr string) error
+	ParseCallbackFromRequest(r *http.Request) (*USDTCallback, error)
+	ParseAndVerifyCallbackFromRequest(r *http.Request) (*USDTCallback, error)
+}
+
+// MYRServicer captures the exported methods of MYRService.
+type MYRServicer interface {
+	Create(ctx context.Context, req *MYRRequest) (*MYRResponse, error)
+	GetStatus(ctx context.Context, transactionID string) (*MYRStatusResponse, error)
+	VerifySignature(paymentID, amountStr, transactionID string, status constants.PaymentStatus, signature string) error
+	VerifyStatusSignature(status *MYRStatusResponse) error
+	VerifyCallback(cb *MYRCallback) error
+	VerifyCallbackWithIP(cb *MYRCallback, remoteAddr string) error
+}
+
+// Compile-time checks that the concrete services satisfy their interfaces.
+var (
+	_ IDRServicer  = (*IDRService)(nil)
+	_ USDTServicer = (*USDTService)(nil)
+	_ MYRServicer  = (*MYRService)(nil)
+)
@@ -0,0 +1,54 @@
+package payment
+
+import (
+	"context"
+	"testing"
+)
+
+// mockIDRServicer is a handwritten mock of IDRServicer. Embedding the
+// interface keeps the mock small: only the methods a test overrides need
+// a func field, and calling anything else panics loudly.
+type mockIDRServicer struct {
+	IDRServicer
+	createFunc    func(ctx context.Context, req *IDRRequest) (*IDRResponse, error)
+	getStatusFunc func(ctx context.Context, transactionID string) (*IDRStatusResponse, error)
+}
+
+func (m *mockIDRServicer) Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
+	return m.createFunc(ctx, req)
+}
+
+func (m *mockIDRServicer) GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error) {
+	return m.getStatusFunc(ctx, transactionID)
+}
+
+// mockUSDTServicer is a handwritten mock of USDTServicer.
+type mockUSDTServicer struct {
+	USDTServicer
+	createFunc func(ctx context.Context, req *USDTRequest) (*USDTResponse, error)
+}
+
+func (m *mockUSDTServicer) Create(ctx context.Context, req *USDTRequest) (*USDTResponse, error) {
+	return m.createFunc(ctx, req)
+}
+
+var (
+	_ IDRServicer  = (*mockIDRServicer)(nil)
+	_ USDTServicer = (*mockUSDTServicer)(nil)
+)
+
+func TestMockIDRServicerSubstitutes(t *testing.T) {
+	mock := &mockIDRServicer{
+		createFunc: func(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
+			return &IDRResponse{TransactionID: req.TransactionID}, nil
+		},
+	}
+	var svc IDRServicer = mock
+	resp, err := svc.Create(context.Background(), &IDRRequest{TransactionID: "TXN12345"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.TransactionID != "TXN12345" {
+		t.Errorf("TransactionID = %q, want TXN12345", resp.TransactionID)
+	}
+}
@@ -0,0 +1,195 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/helper/amount"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// MYRService creates and tracks Malaysian Ringgit payments.
+type MYRService struct {
+	client client.Doer
+}
+
+// NewMYRService returns an MYRService backed by the given client.
+func NewMYRService(c client.Doer) *MYRService {
+	return &MYRService{client: c}
+}
+
+// MYRRequest describes a new MYR payment.
+type MYRRequest struct {
+	// Amount is the payment amount in sen (MYR cents).
+	Amount int64
+	// BankCode selects the paying bank and must be a key of
+	// constants.BanksMYR (case-insensitive).
+	BankCode string
+	// TransactionID is the operator's unique reference, 5–20 characters.
+	TransactionID string
+	// Username identifies the paying end user on the operator's side.
+	Username string
+}
+
+// MYRResponse is the payload returned when an MYR payment is created.
+type MYRResponse struct {
+	MYRPaymentID  json.Number `json:"myrpayment_id"`
+	TransactionID string      `json:"transaction_id"`
+	Amount        string      `json:"amount"`
+	PaymentURL    string      `json:"payment_url"`
+	ExpireDate    string      `json:"expire_date"`
+}
+
+// MYRStatusResponse is the payload returned by GetStatus.
+type MYRStatusResponse struct {
+	MYRPaymentID  json.Number             `json:"myrpayment_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Completed     bool                    `json:"completed"`
+	Success       bool                    `json:"success"`
+	Remark        string                  `json:"remark"`
+	Signature     string                  `json:"signature"`
+}
+
+// MYRCallback is the JSON body GSPAY2 posts to the operator's callback URL
+// when an MYR payment reaches a final state.
+type MYRCallback struct {
+	MYRPaymentID  json.Number             `json:"myrpayment_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Signature     string                  `json:"signature"`
+}
+
+type myrCreateRequest struct {
+	Amount        int64  `json:"amount"`
+	BankCode      string `json:"bank_code"`
+	TransactionID string `json:"transaction_id"`
+	Username      string `json:"username"`
+}
+
+// Create submits a new MYR payment.
+func (s *MYRService) Create(ctx context.Context, req *MYRRequest) (*MYRResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.myr.create")
+	defer span.End()
+	if l := len(req.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		return nil, s.client.Error(errors.ErrInvalidTransactionID, req.TransactionID)
+	}
+	if req.Amount < constants.MinAmountMYR {
+		return nil, s.client.Error(errors.ErrInvalidAmount, req.Amount)
+	}
+	bankCode := strings.ToUpper(req.BankCode)
+	if _, ok := constants.BanksMYR[bankCode]; !ok {
+		return nil, s.client.Error(errors.ErrInvalidBankCode, req.BankCode)
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingMYRPayment),
+		"transactionID", req.TransactionID,
+		"amount", req.Amount,
+		"bankCode", bankCode)
+	endpoint := s.client.Endpoint(constants.EndpointMYRCreate)
+	var resp MYRResponse
+	if err := s.client.Post(ctx, endpoint, &myrCreateRequest{
+		Amount:        req.Amount,
+		BankCode:      bankCode,
+		TransactionID: req.TransactionID,
+		Username:      req.Username,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogMYRPaymentCreated),
+		"transactionID", resp.TransactionID,
+		"paymentID", resp.MYRPaymentID.String())
+	return &resp, nil
+}
+
+// GetStatus fetches the current status of an MYR payment by the operator's
+// transaction ID.
+func (s *MYRService) GetStatus(ctx context.Context, transactionID string) (*MYRStatusResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.myr.get_status")
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingMYRPaymentStatus),
+		"transactionID", transactionID)
+	endpoint := s.client.Endpoint(constants.EndpointMYRStatus)
+	endpoint += "?transaction_id=" + url.QueryEscape(transactionID)
+	var status MYRStatusResponse
+	if err := s.client.Get(ctx, endpoint, &status); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogMYRPaymentStatusRetrieved),
+		"transactionID", status.TransactionID,
+		"status", status.Status.String())
+	return &status, nil
+}
+
+// VerifySignature checks an MYR signature built from the raw field values:
+// MD5(myrpayment_id + amount + transaction_id + status + secret_key),
+// where amount is normalized to two decimal places.
+func (s *MYRService) VerifySignature(paymentID, amountStr, transactionID string, status constants.PaymentStatus, signature string) error {
+	if paymentID == "" || amountStr == "" || transactionID == "" || signature == "" {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogMYRCallbackSignatureFailed),
+			"transactionID", transactionID, "reason", "missing field")
+		return s.client.Error(errors.ErrMissingCallbackField)
+	}
+	formatted, err := amount.Format(amountStr, s.client.Lang())
+	if err != nil {
+		return err
+	}
+	if !s.client.VerifySignature(signature, paymentID, formatted, transactionID, strconv.Itoa(int(status))) {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogMYRCallbackSignatureFailed),
+			"transactionID", transactionID, "paymentID", paymentID)
+		return s.client.Error(errors.ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// VerifyStatusSignature checks the signature on a GetStatus response.
+func (s *MYRService) VerifyStatusSignature(status *MYRStatusResponse) error {
+	return s.VerifySignature(
+		status.MYRPaymentID.String(),
+		status.Amount.String(),
+		status.TransactionID,
+		status.Status,
+		status.Signature,
+	)
+}
+
+// VerifyCallback checks the signature on a callback body. Callers that
+// know the source address should prefer VerifyCallbackWithIP.
+func (s *MYRService) VerifyCallback(cb *MYRCallback) error {
+	return s.VerifySignature(
+		cb.MYRPaymentID.String(),
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Status,
+		cb.Signature,
+	)
+}
+
+// VerifyCallbackWithIP checks the callback source address against the
+// configured whitelist and then verifies the callback signature.
+func (s *MYRService) VerifyCallbackWithIP(cb *MYRCallback, remoteAddr string) error {
+	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingMYRCallback),
+		"transactionID", cb.TransactionID,
+		"paymentID", cb.MYRPaymentID.String(),
+		"sourceIP", remoteAddr)
+	if err := s.client.VerifyCallbackIP(remoteAddr); err != nil {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogMYRCallbackIPFailed),
+			"transactionID", cb.TransactionID,
+			"sourceIP", remoteAddr)
+		return err
+	}
+	if err := s.VerifyCallback(cb); err != nil {
+		return err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogMYRCallbackVerified),
+		"transactionID", cb.TransactionID,
+		"paymentID", cb.MYRPaymentID.String())
+	return nil
+}
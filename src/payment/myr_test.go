@@ -0,0 +1,121 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestMYRServiceCreateValidation(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewMYRService(c)
+
+	_, err := svc.Create(context.Background(), &MYRRequest{
+		Amount:        5000,
+		BankCode:      "MAYBANK",
+		TransactionID: "abc",
+	})
+	if !errors.Is(err, errors.ErrInvalidTransactionID) {
+		t.Errorf("short transaction ID: error = %v, want ErrInvalidTransactionID", err)
+	}
+
+	_, err = svc.Create(context.Background(), &MYRRequest{
+		Amount:        50,
+		BankCode:      "MAYBANK",
+		TransactionID: "TXN12345",
+	})
+	if !errors.Is(err, errors.ErrInvalidAmount) {
+		t.Errorf("low amount: error = %v, want ErrInvalidAmount", err)
+	}
+
+	_, err = svc.Create(context.Background(), &MYRRequest{
+		Amount:        5000,
+		BankCode:      "NOTABANK",
+		TransactionID: "TXN12345",
+	})
+	if !errors.Is(err, errors.ErrInvalidBankCode) {
+		t.Errorf("unknown bank: error = %v, want ErrInvalidBankCode", err)
+	}
+}
+
+func TestMYRServiceCreateNormalizesBankCode(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			BankCode string `json:"bank_code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.BankCode != "CIMB" {
+			t.Errorf("bank_code = %q, want CIMB", body.BankCode)
+		}
+		w.Write(envelope(t, map[string]any{
+			"myrpayment_id":  55,
+			"transaction_id": "TXN12345",
+			"amount":         "50.00",
+			"payment_url":    "https://pay.example/m/55",
+		}))
+	})
+	svc := NewMYRService(c)
+	resp, err := svc.Create(context.Background(), &MYRRequest{
+		Amount:        5000,
+		BankCode:      "cimb",
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.MYRPaymentID.String() != "55" {
+		t.Errorf("MYRPaymentID = %q, want 55", resp.MYRPaymentID)
+	}
+}
+
+func TestMYRServiceGetStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("transaction_id"); got != "TXN12345" {
+			t.Errorf("transaction_id = %q, want TXN12345", got)
+		}
+		w.Write(envelope(t, map[string]any{
+			"myrpayment_id":  55,
+			"transaction_id": "TXN12345",
+			"amount":         "50.00",
+			"status":         1,
+			"completed":      true,
+			"success":        true,
+		}))
+	})
+	svc := NewMYRService(c)
+	status, err := svc.GetStatus(context.Background(), "TXN12345")
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if status.Status != constants.StatusSuccess {
+		t.Errorf("Status = %v, want StatusSuccess", status.Status)
+	}
+}
+
+func TestMYRServiceVerifyCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewMYRService(c)
+	cb := &MYRCallback{
+		MYRPaymentID:  "55",
+		TransactionID: "TXN12345",
+		Amount:        "50",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("55" + "50.00" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Fatalf("VerifyCallback: %v", err)
+	}
+
+	cb.Signature = md5Hex("bad")
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+}
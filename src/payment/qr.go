@@ -0,0 +1,75 @@
+package payment
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// QRPaymentResult is the subset of an IDR payment response a QRIS
+// integration presents to the end user.
+type QRPaymentResult struct {
+	// QRCode is the QRIS payload to render as a QR image.
+	QRCode string
+	// PaymentURL is the hosted payment page as a fallback.
+	PaymentURL string
+	// IDRPaymentID is GSPAY2's payment identifier.
+	IDRPaymentID string
+	// ExpireDate is when the payment expires.
+	ExpireDate string
+	// Amount is the confirmed payment amount.
+	Amount string
+}
+
+// CreateQROnly creates a QRIS payment and returns just the fields a QR
+// integration needs. The request channel is forced to QRIS; a request
+// that names a different channel is rejected with a validation error on
+// the channel field. An empty QR string in the response surfaces as
+// errors.ErrEmptyQRCode.
+func (s *IDRService) CreateQROnly(ctx context.Context, req *IDRRequest) (*QRPaymentResult, error) {
+	if err := s.requireChannel(req, constants.ChannelQRIS); err != nil {
+		return nil, err
+	}
+	resp, err := s.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.QR == "" {
+		return nil, s.client.Error(errors.ErrEmptyQRCode, req.TransactionID)
+	}
+	return &QRPaymentResult{
+		QRCode:       resp.QR,
+		PaymentURL:   resp.PaymentURL,
+		IDRPaymentID: resp.IDRPaymentID.String(),
+		ExpireDate:   resp.ExpireDate,
+		Amount:       resp.Amount,
+	}, nil
+}
+
+// CreateDANALink creates a DANA e-wallet payment and returns just the
+// hosted payment URL. The request channel is forced to DANA; a request
+// that names a different channel is rejected with a validation error on
+// the channel field.
+func (s *IDRService) CreateDANALink(ctx context.Context, req *IDRRequest) (string, error) {
+	if err := s.requireChannel(req, constants.ChannelDANA); err != nil {
+		return "", err
+	}
+	resp, err := s.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.PaymentURL, nil
+}
+
+// requireChannel pins the request to channel, rejecting requests that
+// already name a different one.
+func (s *IDRService) requireChannel(req *IDRRequest, channel constants.ChannelIDR) error {
+	if req.Channel != "" && strings.ToUpper(req.Channel) != string(channel) {
+		return errors.NewValidationError(s.client.Lang(), "channel", i18n.MsgInvalidChannel, errors.ErrInvalidChannel)
+	}
+	req.Channel = string(channel)
+	return nil
+}
@@ -0,0 +1,107 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestCreateQROnlyForcesQRISChannel(t *testing.T) {
+	var gotChannel string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Channel string `json:"channel"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		gotChannel = body.Channel
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  31,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"payment_url":    "https://pay.example/31",
+			"qr":             "00020101021226",
+			"expire_date":    "2026-01-01 00:00:00",
+		}))
+	})
+	svc := NewIDRService(c)
+	result, err := svc.CreateQROnly(context.Background(), &IDRRequest{
+		Amount: 50000, TransactionID: "TXN12345", Username: "user1",
+	})
+	if err != nil {
+		t.Fatalf("CreateQROnly: %v", err)
+	}
+	if gotChannel != "QRIS" {
+		t.Errorf("channel = %q, want QRIS", gotChannel)
+	}
+	if result.QRCode != "00020101021226" || result.IDRPaymentID != "31" {
+		t.Errorf("result = %+v, want QR and payment ID from the response", result)
+	}
+}
+
+func TestCreateQROnlyEmptyQR(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  31,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"payment_url":    "https://pay.example/31",
+		}))
+	})
+	svc := NewIDRService(c)
+	_, err := svc.CreateQROnly(context.Background(), &IDRRequest{
+		Amount: 50000, TransactionID: "TXN12345", Username: "user1",
+	})
+	if !errors.Is(err, errors.ErrEmptyQRCode) {
+		t.Errorf("CreateQROnly error = %v, want ErrEmptyQRCode", err)
+	}
+}
+
+func TestCreateQROnlyRejectsOtherChannels(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent despite channel mismatch")
+	})
+	svc := NewIDRService(c)
+	_, err := svc.CreateQROnly(context.Background(), &IDRRequest{
+		Amount: 50000, TransactionID: "TXN12345", Username: "user1", Channel: "DANA",
+	})
+	if !errors.Is(err, errors.ErrInvalidChannel) {
+		t.Errorf("CreateQROnly error = %v, want ErrInvalidChannel", err)
+	}
+}
+
+func TestCreateDANALink(t *testing.T) {
+	var gotChannel string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Channel string `json:"channel"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		gotChannel = body.Channel
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  32,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"payment_url":    "https://pay.example/32",
+		}))
+	})
+	svc := NewIDRService(c)
+	url, err := svc.CreateDANALink(context.Background(), &IDRRequest{
+		Amount: 50000, TransactionID: "TXN12345", Username: "user1",
+	})
+	if err != nil {
+		t.Fatalf("CreateDANALink: %v", err)
+	}
+	if gotChannel != "DANA" {
+		t.Errorf("channel = %q, want DANA", gotChannel)
+	}
+	if url != "https://pay.example/32" {
+		t.Errorf("url = %q, want payment URL from the response", url)
+	}
+}
@@ -0,0 +1,102 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// RefundRequest describes a full or partial refund of an earlier IDR
+// payment.
+type RefundRequest struct {
+	// OriginalTransactionID is the operator's reference for the payment
+	// being refunded.
+	OriginalTransactionID string
+	// RefundTransactionID is the operator's unique reference for the
+	// refund itself, 5–20 characters.
+	RefundTransactionID string
+	// Amount is the refund amount in whole Rupiah. It may be less than the
+	// original payment amount for a partial refund.
+	Amount int64
+	// Reason optionally records why the refund was issued.
+	Reason string
+}
+
+// RefundResponse is the payload returned when a refund is created.
+type RefundResponse struct {
+	RefundID json.Number             `json:"refund_id"`
+	Status   constants.PaymentStatus `json:"status"`
+	Amount   json.Number             `json:"amount"`
+}
+
+type idrRefundRequest struct {
+	OriginalTransactionID string `json:"original_transaction_id"`
+	RefundTransactionID   string `json:"refund_transaction_id"`
+	Amount                int64  `json:"amount"`
+	Reason                string `json:"reason,omitempty"`
+	Signature             string `json:"signature"`
+}
+
+// Validate checks the request fields without making a network call. Both
+// transaction IDs follow the usual length bounds. It returns a
+// *errors.ValidationError for a single invalid field, or an
+// *errors.ErrorGroup listing every invalid field.
+func (r *RefundRequest) Validate(lang i18n.Language) error {
+	g := errors.NewErrorGroup()
+	g.Lang = lang
+	if l := len(r.OriginalTransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		g.Add(errors.NewValidationError(lang, "original_transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if l := len(r.RefundTransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		g.Add(errors.NewValidationError(lang, "refund_transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if r.Amount <= 0 {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	return g.Err()
+}
+
+// RefundSignature computes the signature GSPAY2 expects on a refund:
+// MD5(refund_transaction_id + original_transaction_id + amount +
+// secret_key).
+func (s *IDRService) RefundSignature(req *RefundRequest) string {
+	return s.client.GenerateSignature(
+		req.RefundTransactionID,
+		req.OriginalTransactionID,
+		strconv.FormatInt(req.Amount, 10),
+	)
+}
+
+// Refund asks GSPAY2 to return some or all of a completed payment to the
+// end user. The refund is referenced by its own transaction ID and can be
+// tracked like a payment.
+func (s *IDRService) Refund(ctx context.Context, req *RefundRequest) (*RefundResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.idr.refund")
+	defer span.End()
+	if err := req.Validate(s.client.Lang()); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingIDRRefund),
+		"refundTransactionID", req.RefundTransactionID,
+		"originalTransactionID", req.OriginalTransactionID,
+		"amount", req.Amount)
+	endpoint := s.client.Endpoint(constants.EndpointIDRRefund)
+	var resp RefundResponse
+	if err := s.client.Post(ctx, endpoint, &idrRefundRequest{
+		OriginalTransactionID: req.OriginalTransactionID,
+		RefundTransactionID:   req.RefundTransactionID,
+		Amount:                req.Amount,
+		Reason:                req.Reason,
+		Signature:             s.RefundSignature(req),
+	}, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRRefundCreated),
+		"refundTransactionID", req.RefundTransactionID,
+		"refundID", resp.RefundID.String())
+	return &resp, nil
+}
@@ -0,0 +1,95 @@
+package payment
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestRefundRequestValidate(t *testing.T) {
+	valid := &RefundRequest{
+		OriginalTransactionID: "TXN12345",
+		RefundTransactionID:   "RFD12345",
+		Amount:                50000,
+	}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid request: Validate = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name  string
+		req   RefundRequest
+		field string
+	}{
+		{"short refund ID", RefundRequest{OriginalTransactionID: "TXN12345", RefundTransactionID: "abc", Amount: 50000}, "refund_transaction_id"},
+		{"short original ID", RefundRequest{OriginalTransactionID: "abc", RefundTransactionID: "RFD12345", Amount: 50000}, "original_transaction_id"},
+		{"zero amount", RefundRequest{OriginalTransactionID: "TXN12345", RefundTransactionID: "RFD12345"}, "amount"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate(i18n.English)
+			if ve := errors.GetValidationError(err); ve == nil || ve.Field != tt.field {
+				t.Errorf("Validate = %v, want ValidationError on %s", err, tt.field)
+			}
+		})
+	}
+}
+
+func TestRefundSignature(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	req := &RefundRequest{
+		OriginalTransactionID: "TXN12345",
+		RefundTransactionID:   "RFD12345",
+		Amount:                25000,
+	}
+	sum := md5.Sum([]byte("RFD12345" + "TXN12345" + "25000" + testSecretKey))
+	want := hex.EncodeToString(sum[:])
+	if got := svc.RefundSignature(req); got != want {
+		t.Errorf("RefundSignature = %q, want %q", got, want)
+	}
+}
+
+func TestIDRServiceRefund(t *testing.T) {
+	var body idrRefundRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		w.Write(envelope(t, map[string]any{
+			"refund_id": 99,
+			"status":    1,
+			"amount":    "25000",
+		}))
+	})
+	svc := NewIDRService(c)
+	resp, err := svc.Refund(context.Background(), &RefundRequest{
+		OriginalTransactionID: "TXN12345",
+		RefundTransactionID:   "RFD12345",
+		Amount:                25000,
+		Reason:                "duplicate order",
+	})
+	if err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+	if resp.RefundID.String() != "99" {
+		t.Errorf("RefundID = %q, want 99", resp.RefundID.String())
+	}
+	if body.Signature != svc.RefundSignature(&RefundRequest{
+		OriginalTransactionID: "TXN12345",
+		RefundTransactionID:   "RFD12345",
+		Amount:                25000,
+	}) {
+		t.Errorf("request signature = %q, want computed refund signature", body.Signature)
+	}
+	if body.Reason != "duplicate order" {
+		t.Errorf("request reason = %q, want duplicate order", body.Reason)
+	}
+}
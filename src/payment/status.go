@@ -0,0 +1,24 @@
+package payment
+
+// Semantic helpers over the multi-field status of an IDR payment, so
+// callers do not need to combine Completed, Success and Status themselves.
+
+// IsCompleted reports whether the payment has reached a final state.
+func (r *IDRStatusResponse) IsCompleted() bool { return r.Completed }
+
+// IsSuccessful reports whether the payment completed and the funds were
+// received.
+func (r *IDRStatusResponse) IsSuccessful() bool {
+	return r.Completed && r.Success && r.Status.IsSuccess()
+}
+
+// IsFailed reports whether the payment completed without the funds being
+// received.
+func (r *IDRStatusResponse) IsFailed() bool {
+	return r.Completed && (!r.Success || r.Status.IsFailed())
+}
+
+// IsProcessing reports whether the payment is still in flight.
+func (r *IDRStatusResponse) IsProcessing() bool {
+	return !r.Completed && r.Status.IsPending()
+}
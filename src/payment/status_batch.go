@@ -0,0 +1,64 @@
+package payment
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+// GetStatusBatch fetches the status of several payments concurrently using
+// a worker pool. Results and failures are returned in separate maps keyed
+// by transaction ID; duplicate IDs are fetched once. concurrency values
+// below one or above constants.MaxBulkConcurrency are clamped to the cap.
+// When the context is cancelled mid-flight, statuses collected so far are
+// returned and the remaining IDs carry the context error, so callers must
+// expect partial results.
+func (s *IDRService) GetStatusBatch(ctx context.Context, transactionIDs []string, concurrency int) (map[string]*IDRStatusResponse, map[string]error) {
+	if concurrency < 1 || concurrency > constants.MaxBulkConcurrency {
+		concurrency = constants.MaxBulkConcurrency
+	}
+	unique := make([]string, 0, len(transactionIDs))
+	seen := make(map[string]struct{}, len(transactionIDs))
+	for _, id := range transactionIDs {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	statuses := make(map[string]*IDRStatusResponse, len(unique))
+	failures := make(map[string]error)
+	var mu sync.Mutex
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				if err := ctx.Err(); err != nil {
+					mu.Lock()
+					failures[id] = err
+					mu.Unlock()
+					continue
+				}
+				status, err := s.GetStatus(ctx, id)
+				mu.Lock()
+				if err != nil {
+					failures[id] = err
+				} else {
+					statuses[id] = status
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, id := range unique {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+	return statuses, failures
+}
@@ -0,0 +1,139 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func statusBatchHandler(t *testing.T, hits *sync.Map) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("transaction_id")
+		if hits != nil {
+			count, _ := hits.LoadOrStore(id, new(atomic.Int64))
+			count.(*atomic.Int64).Add(1)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayment_id":  "7",
+			"transaction_id": id,
+			"amount":         "10000.00",
+			"status":         1,
+			"completed":      true,
+			"success":        true,
+		}))
+	}
+}
+
+func TestGetStatusBatchSuccess(t *testing.T) {
+	svc := NewIDRService(newTestClient(t, statusBatchHandler(t, nil)))
+	ids := []string{"TXN1", "TXN2", "TXN3", "TXN4"}
+	statuses, failures := svc.GetStatusBatch(context.Background(), ids, 2)
+	if len(failures) != 0 {
+		t.Fatalf("failures = %v, want none", failures)
+	}
+	for _, id := range ids {
+		status, ok := statuses[id]
+		if !ok {
+			t.Errorf("no status for %s", id)
+			continue
+		}
+		if status.TransactionID != id {
+			t.Errorf("status keyed %s has TransactionID %s", id, status.TransactionID)
+		}
+	}
+}
+
+func TestGetStatusBatchPartialFailure(t *testing.T) {
+	svc := NewIDRService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("transaction_id")
+		if id == "TXN-BAD" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"not found"}`))
+			return
+		}
+		statusBatchHandler(t, nil)(w, r)
+	}))
+	statuses, failures := svc.GetStatusBatch(context.Background(), []string{"TXN1", "TXN-BAD", "TXN2"}, 3)
+	if len(statuses) != 2 {
+		t.Errorf("statuses = %d entries, want 2", len(statuses))
+	}
+	if err := failures["TXN-BAD"]; errors.GetAPIError(err) == nil {
+		t.Errorf("failures[TXN-BAD] = %v, want an APIError", err)
+	}
+	if len(failures) != 1 {
+		t.Errorf("failures = %v, want only TXN-BAD", failures)
+	}
+}
+
+func TestGetStatusBatchContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	var served atomic.Int64
+	svc := NewIDRService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if served.Add(1) > 1 {
+			<-release
+		}
+		statusBatchHandler(t, nil)(w, r)
+	}))
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		cancel()
+	}()
+	ids := []string{"TXN1", "TXN2", "TXN3", "TXN4", "TXN5"}
+	statuses, failures := svc.GetStatusBatch(ctx, ids, 1)
+	if len(statuses)+len(failures) != len(ids) {
+		t.Fatalf("got %d statuses and %d failures, want %d total", len(statuses), len(failures), len(ids))
+	}
+	if len(statuses) == 0 {
+		t.Error("no partial results before cancellation")
+	}
+	foundCtxErr := false
+	for _, err := range failures {
+		if errors.Is(err, context.Canceled) {
+			foundCtxErr = true
+		}
+	}
+	if !foundCtxErr {
+		t.Errorf("failures = %v, want at least one context.Canceled", failures)
+	}
+}
+
+func TestGetStatusBatchDeduplicates(t *testing.T) {
+	var hits sync.Map
+	svc := NewIDRService(newTestClient(t, statusBatchHandler(t, &hits)))
+	statuses, failures := svc.GetStatusBatch(context.Background(),
+		[]string{"TXN1", "TXN2", "TXN1", "TXN1"}, 2)
+	if len(failures) != 0 {
+		t.Fatalf("failures = %v, want none", failures)
+	}
+	if len(statuses) != 2 {
+		t.Errorf("statuses = %d entries, want 2", len(statuses))
+	}
+	hits.Range(func(id, count any) bool {
+		if n := count.(*atomic.Int64).Load(); n != 1 {
+			t.Errorf("%s fetched %d times, want once", id, n)
+		}
+		return true
+	})
+}
+
+func TestGetStatusBatchOrderIndependent(t *testing.T) {
+	svc := NewIDRService(newTestClient(t, statusBatchHandler(t, nil)))
+	forward, _ := svc.GetStatusBatch(context.Background(), []string{"TXN1", "TXN2", "TXN3"}, 2)
+	reverse, _ := svc.GetStatusBatch(context.Background(), []string{"TXN3", "TXN2", "TXN1"}, 2)
+	if len(forward) != len(reverse) {
+		t.Fatalf("forward %d entries, reverse %d entries", len(forward), len(reverse))
+	}
+	for id := range forward {
+		if _, ok := reverse[id]; !ok {
+			t.Errorf("reverse order is missing %s", id)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package payment
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+func TestIDRCallbackUnmarshalVariants(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+	}{
+		{"canonical types", `{"idrpayment_id":31,"transaction_id":"TXN12345","amount":"50000.00","status":1,"signature":"abc"}`},
+		{"string id and status", `{"idrpayment_id":"31","transaction_id":"TXN12345","amount":"50000.00","status":"1","signature":"abc"}`},
+		{"numeric amount", `{"idrpayment_id":31,"transaction_id":"TXN12345","amount":50000.00,"status":1,"signature":"abc"}`},
+	}
+	for _, tc := range cases {
+		var cb IDRCallback
+		if err := json.Unmarshal([]byte(tc.body), &cb); err != nil {
+			t.Fatalf("%s: Unmarshal: %v", tc.name, err)
+		}
+		if cb.IDRPaymentID.String() != "31" || cb.TransactionID != "TXN12345" || cb.Status != constants.StatusSuccess {
+			t.Errorf("%s: callback = %+v, want id 31, TXN12345, status success", tc.name, cb)
+		}
+		if got, _ := cb.Amount.Float64(); got != 50000 {
+			t.Errorf("%s: amount = %v, want 50000", tc.name, cb.Amount)
+		}
+	}
+}
+
+func TestIDRCallbackUnmarshalRejectsBadTypes(t *testing.T) {
+	cases := []struct {
+		field string
+		body  string
+	}{
+		{"idrpayment_id", `{"idrpayment_id":true,"transaction_id":"TXN12345","amount":"50000.00","status":1}`},
+		{"transaction_id", `{"idrpayment_id":31,"transaction_id":12345,"amount":"50000.00","status":1}`},
+		{"amount", `{"idrpayment_id":31,"transaction_id":"TXN12345","amount":{"v":1},"status":1}`},
+		{"status", `{"idrpayment_id":31,"transaction_id":"TXN12345","amount":"50000.00","status":"paid"}`},
+	}
+	for _, tc := range cases {
+		var cb IDRCallback
+		err := json.Unmarshal([]byte(tc.body), &cb)
+		if err == nil {
+			t.Fatalf("field %s: Unmarshal accepted a bad type", tc.field)
+		}
+		if !strings.Contains(err.Error(), tc.field) {
+			t.Errorf("field %s: error = %v, want the field named", tc.field, err)
+		}
+	}
+}
+
+func TestUSDTCallbackUnmarshalVariants(t *testing.T) {
+	body := `{"cryptopayment_id":"7","transaction_id":"TXN12345","amount":25.5,"status":"2","signature":"abc"}`
+	var cb USDTCallback
+	if err := json.Unmarshal([]byte(body), &cb); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if cb.CryptoPaymentID.String() != "7" || cb.Status != constants.StatusFailed {
+		t.Errorf("callback = %+v, want id 7 and status failed", cb)
+	}
+	if got, _ := cb.Amount.Float64(); got != 25.5 {
+		t.Errorf("amount = %v, want 25.5", cb.Amount)
+	}
+}
+
+func TestUSDTCallbackUnmarshalRejectsBadStatus(t *testing.T) {
+	var cb USDTCallback
+	err := json.Unmarshal([]byte(`{"cryptopayment_id":7,"transaction_id":"TXN12345","amount":"25.50","status":1.5}`), &cb)
+	if err == nil || !strings.Contains(err.Error(), "status") {
+		t.Errorf("error = %v, want a status type error", err)
+	}
+}
@@ -0,0 +1,313 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/helper/amount"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/internal/flexjson"
+)
+
+// USDTNetwork selects the blockchain network a USDT payment settles on.
+// It aliases constants.USDTNetwork so literals from either package
+// interoperate.
+type USDTNetwork = constants.USDTNetwork
+
+const (
+	// NetworkTRC20 settles USDT over TRON and is the default.
+	NetworkTRC20 = constants.NetworkTRC20
+	// NetworkERC20 settles USDT over Ethereum.
+	NetworkERC20 = constants.NetworkERC20
+)
+
+// USDTService creates and tracks USDT payments over TRC20 or ERC20.
+type USDTService struct {
+	client client.Doer
+	hooks  USDTHooks
+}
+
+// NewUSDTService returns a USDTService backed by the given client.
+func NewUSDTService(c client.Doer, opts ...USDTServiceOption) *USDTService {
+	s := &USDTService{client: c}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// USDTRequest describes a new USDT payment.
+type USDTRequest struct {
+	// Amount is the payment amount in USDT.
+	Amount float64
+	// TransactionID is the operator's unique reference, 5–20 characters.
+	TransactionID string
+	// Username identifies the paying end user on the operator's side.
+	Username string
+	// Network selects the settlement network. The zero value defaults to
+	// NetworkTRC20.
+	Network USDTNetwork
+}
+
+// USDTResponse is the payload returned when a USDT payment is created.
+type USDTResponse struct {
+	CryptoPaymentID json.Number `json:"cryptopayment_id"`
+	TransactionID   string      `json:"transaction_id"`
+	Amount          string      `json:"amount"`
+	PaymentURL      string      `json:"payment_url"`
+	ExpireDate      string      `json:"expire_date"`
+}
+
+// USDTStatusResponse is the payload returned by GetStatus.
+type USDTStatusResponse struct {
+	CryptoPaymentID string                  `json:"cryptopayment_id"`
+	TransactionID   string                  `json:"transaction_id"`
+	PlayerUsername  string                  `json:"player_username"`
+	Amount          json.Number             `json:"amount"`
+	Status          constants.PaymentStatus `json:"status"`
+	Completed       bool                    `json:"completed"`
+	Success         bool                    `json:"success"`
+	Remark          string                  `json:"remark"`
+	Signature       string                  `json:"signature"`
+}
+
+// USDTCallback is the JSON body GSPAY2 posts to the operator's callback
+// URL when a USDT payment reaches a final state.
+type USDTCallback struct {
+	CryptoPaymentID json.Number             `json:"cryptopayment_id"`
+	TransactionID   string                  `json:"transaction_id"`
+	Amount          json.Number             `json:"amount"`
+	Status          constants.PaymentStatus `json:"status"`
+	Signature       string                  `json:"signature"`
+}
+
+// UnmarshalJSON decodes the callback tolerantly, accepting identifiers
+// and amounts as JSON numbers or quoted strings and the status as an
+// integer or string integer. A value of the wrong type is rejected with
+// an error naming the field.
+func (cb *USDTCallback) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var err error
+	if cb.CryptoPaymentID, err = flexjson.Number("cryptopayment_id", fields["cryptopayment_id"]); err != nil {
+		return err
+	}
+	if cb.TransactionID, err = flexjson.String("transaction_id", fields["transaction_id"]); err != nil {
+		return err
+	}
+	if cb.Amount, err = flexjson.Number("amount", fields["amount"]); err != nil {
+		return err
+	}
+	status, err := flexjson.Int("status", fields["status"])
+	if err != nil {
+		return err
+	}
+	cb.Status = constants.PaymentStatus(status)
+	cb.Signature, err = flexjson.String("signature", fields["signature"])
+	return err
+}
+
+// Validate checks the callback fields structurally, without touching
+// cryptography. It returns a *errors.ValidationError or an
+// *errors.ErrorGroup.
+func (cb *USDTCallback) Validate(lang i18n.Language) error {
+	g := errors.NewErrorGroup()
+	g.Lang = lang
+	if l := len(cb.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		g.Add(errors.NewValidationError(lang, "transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if f, err := strconv.ParseFloat(cb.Amount.String(), 64); err != nil {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat, errors.ErrInvalidAmount))
+	} else if f < 0 {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	return g.Err()
+}
+
+type usdtCreateRequest struct {
+	Amount        float64 `json:"amount"`
+	TransactionID string  `json:"transaction_id"`
+	Username      string  `json:"username"`
+}
+
+// Validate checks the request fields without making a network call. It
+// returns a *errors.MultiValidationError listing every invalid field, or
+// nil when the request is valid.
+func (r *USDTRequest) Validate(lang i18n.Language) *errors.MultiValidationError {
+	m := errors.NewMultiValidationError(lang)
+	if l := len(r.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		m.Add(errors.NewValidationError(lang, "transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if r.Amount < constants.MinAmountUSDT {
+		m.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	if r.Network != "" && !constants.IsValidUSDTNetwork(USDTNetwork(strings.ToLower(string(r.Network)))) {
+		m.Add(errors.NewValidationError(lang, "network", i18n.MsgInvalidNetwork))
+	}
+	return m.Err()
+}
+
+// Create submits a new USDT payment.
+func (s *USDTService) Create(ctx context.Context, req *USDTRequest) (*USDTResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.usdt.create")
+	defer span.End()
+	if err := req.Validate(s.client.Lang()); err != nil {
+		return nil, err
+	}
+	network := USDTNetwork(strings.ToLower(string(req.Network)))
+	if network == "" {
+		network = NetworkTRC20
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingUSDTPayment),
+		"transactionID", req.TransactionID,
+		"amount", req.Amount,
+		"network", string(network))
+	endpoint := fmt.Sprintf(s.client.Endpoint(constants.EndpointUSDTCreateByNetwork), network)
+	var resp USDTResponse
+	if err := s.client.Post(ctx, endpoint, &usdtCreateRequest{
+		Amount:        req.Amount,
+		TransactionID: req.TransactionID,
+		Username:      req.Username,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogUSDTPaymentCreated),
+		"transactionID", resp.TransactionID,
+		"paymentID", resp.CryptoPaymentID.String())
+	if s.hooks.OnPaymentCreated != nil {
+		s.hooks.OnPaymentCreated(ctx, &resp)
+	}
+	return &resp, nil
+}
+
+// GetStatus fetches the current status of a USDT payment by the operator's
+// transaction ID.
+func (s *USDTService) GetStatus(ctx context.Context, transactionID string) (*USDTStatusResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payment.usdt.get_status")
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingUSDTPaymentStatus),
+		"transactionID", transactionID)
+	endpoint := s.client.Endpoint(constants.EndpointUSDTStatus)
+	endpoint += "?transaction_id=" + url.QueryEscape(transactionID)
+	var status USDTStatusResponse
+	if err := s.client.Get(ctx, endpoint, &status); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogUSDTPaymentStatusRetrieved),
+		"transactionID", status.TransactionID,
+		"status", status.Status.String())
+	if s.hooks.OnStatusRetrieved != nil {
+		s.hooks.OnStatusRetrieved(ctx, &status)
+	}
+	return &status, nil
+}
+
+// VerifySignature checks a USDT signature built from the raw field values:
+// MD5(cryptopayment_id + amount + transaction_id + status + secret_key),
+// where amount is normalized to two decimal places.
+func (s *USDTService) VerifySignature(cryptoPaymentID, amountStr, transactionID string, status constants.PaymentStatus, signature string) error {
+	if cryptoPaymentID == "" || amountStr == "" || transactionID == "" || signature == "" {
+		return s.client.Error(errors.ErrMissingCallbackField)
+	}
+	formatted, err := amount.Format(amountStr, s.client.Lang())
+	if err != nil {
+		return err
+	}
+	if !s.client.VerifySignature(signature, cryptoPaymentID, formatted, transactionID, strconv.Itoa(int(status))) {
+		return s.client.Error(errors.ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// VerifyStatusSignature checks the signature on a GetStatus response.
+func (s *USDTService) VerifyStatusSignature(status *USDTStatusResponse) error {
+	return s.VerifySignature(
+		status.CryptoPaymentID,
+		status.Amount.String(),
+		status.TransactionID,
+		status.Status,
+		status.Signature,
+	)
+}
+
+// VerifyCallback validates the callback structurally and then checks its
+// signature.
+func (s *USDTService) VerifyCallback(cb *USDTCallback) error {
+	if err := cb.Validate(s.client.Lang()); err != nil {
+		return err
+	}
+	return s.VerifySignature(
+		cb.CryptoPaymentID.String(),
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Status,
+		cb.Signature,
+	)
+}
+
+// VerifyCallbackWithIP checks the callback source address against the
+// configured whitelist and then verifies the callback signature.
+func (s *USDTService) VerifyCallbackWithIP(cb *USDTCallback, remoteAddr string) error {
+	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingUSDTCallback),
+		"transactionID", cb.TransactionID,
+		"cryptoPaymentID", cb.CryptoPaymentID.String(),
+		"sourceIP", remoteAddr)
+	if err := s.client.VerifyCallbackIP(remoteAddr); err != nil {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogUSDTCallbackIPFailed),
+			"transactionID", cb.TransactionID,
+			"sourceIP", remoteAddr)
+		if s.hooks.OnCallbackFailed != nil {
+			s.hooks.OnCallbackFailed(context.Background(), err)
+		}
+		return err
+	}
+	if err := s.VerifyCallback(cb); err != nil {
+		if s.hooks.OnCallbackFailed != nil {
+			s.hooks.OnCallbackFailed(context.Background(), err)
+		}
+		return err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogUSDTCallbackVerified),
+		"transactionID", cb.TransactionID,
+		"cryptoPaymentID", cb.CryptoPaymentID.String())
+	if s.hooks.OnCallbackVerified != nil {
+		s.hooks.OnCallbackVerified(context.Background(), cb)
+	}
+	return nil
+}
+
+// ParseCallbackFromRequest decodes a USDT payment callback from an incoming
+// webhook request. It enforces the POST method, the application/json
+// content type and the client's maximum callback body size, and returns
+// the decoded callback without verifying its signature.
+func (s *USDTService) ParseCallbackFromRequest(r *http.Request) (*USDTCallback, error) {
+	var cb USDTCallback
+	if err := s.client.ParseCallbackBody(r, &cb); err != nil {
+		return nil, err
+	}
+	return &cb, nil
+}
+
+// ParseAndVerifyCallbackFromRequest decodes a USDT payment callback and then
+// verifies its source IP and signature. It is the recommended entry point
+// for webhook handlers.
+func (s *USDTService) ParseAndVerifyCallbackFromRequest(r *http.Request) (*USDTCallback, error) {
+	cb, err := s.ParseCallbackFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.VerifyCallbackWithIP(cb, r.RemoteAddr); err != nil {
+		return nil, err
+	}
+	return cb, nil
+}
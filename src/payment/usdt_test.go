@@ -0,0 +1,240 @@
+package payment
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// mockLogger records log calls so tests can assert on messages and
+// key-value pairs.
+type mockLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+type logEntry struct {
+	level string
+	msg   string
+	kvs   []any
+}
+
+func (m *mockLogger) record(level, msg string, kvs []any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, logEntry{level: level, msg: msg, kvs: kvs})
+}
+
+func (m *mockLogger) Debug(msg string, kvs ...any) { m.record("debug", msg, kvs) }
+func (m *mockLogger) Info(msg string, kvs ...any)  { m.record("info", msg, kvs) }
+func (m *mockLogger) Warn(msg string, kvs ...any)  { m.record("warn", msg, kvs) }
+func (m *mockLogger) Error(msg string, kvs ...any) { m.record("error", msg, kvs) }
+
+// hasKV reports whether any recorded entry carries the key-value pair.
+func (m *mockLogger) hasKV(key string, value any) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.entries {
+		for i := 0; i+1 < len(e.kvs); i += 2 {
+			if e.kvs[i] == key && fmt.Sprint(e.kvs[i+1]) == fmt.Sprint(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasMsg reports whether an entry with the level and message was logged.
+func (m *mockLogger) hasMsg(level, msg string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.entries {
+		if e.level == level && e.msg == msg {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	testAuthKey   = "auth-key"
+	testSecretKey = "secret-key"
+)
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...client.Option) *client.Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	opts = append([]client.Option{
+		client.WithBaseURL(srv.URL),
+		client.WithRetries(0),
+	}, opts...)
+	return client.New(testAuthKey, testSecretKey, opts...)
+}
+
+func envelope(t *testing.T, data any) []byte {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]any{"code": 200, "message": "OK", "data": json.RawMessage(raw)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestUSDTServiceGetStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("method = %s, want GET", r.Method)
+		}
+		if got := r.URL.Query().Get("transaction_id"); got != "TXN12345" {
+			t.Errorf("transaction_id = %q, want TXN12345", got)
+		}
+		w.Write(envelope(t, map[string]any{
+			"cryptopayment_id": "991",
+			"transaction_id":   "TXN12345",
+			"player_username":  "user1",
+			"amount":           "25.50",
+			"status":           1,
+			"completed":        true,
+			"success":          true,
+		}))
+	})
+	svc := NewUSDTService(c)
+	status, err := svc.GetStatus(context.Background(), "TXN12345")
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if status.CryptoPaymentID != "991" {
+		t.Errorf("CryptoPaymentID = %q, want 991", status.CryptoPaymentID)
+	}
+	if status.PlayerUsername != "user1" {
+		t.Errorf("PlayerUsername = %q, want user1", status.PlayerUsername)
+	}
+	if status.Status != constants.StatusSuccess {
+		t.Errorf("Status = %v, want StatusSuccess", status.Status)
+	}
+	if !status.Completed || !status.Success {
+		t.Errorf("Completed/Success = %v/%v, want true/true", status.Completed, status.Success)
+	}
+}
+
+func TestUSDTServiceVerifyStatusSignature(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewUSDTService(c)
+	status := &USDTStatusResponse{
+		CryptoPaymentID: "991",
+		TransactionID:   "TXN12345",
+		Amount:          "25.5",
+		Status:          constants.StatusSuccess,
+		Signature:       md5Hex("991" + "25.50" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyStatusSignature(status); err != nil {
+		t.Fatalf("VerifyStatusSignature: %v", err)
+	}
+
+	status.Signature = md5Hex("tampered")
+	if err := svc.VerifyStatusSignature(status); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+
+	status.Signature = ""
+	if err := svc.VerifyStatusSignature(status); !errors.Is(err, errors.ErrMissingCallbackField) {
+		t.Fatalf("error = %v, want ErrMissingCallbackField", err)
+	}
+}
+
+func TestUSDTServiceVerifyCallbackWithIPLogs(t *testing.T) {
+	ml := &mockLogger{}
+	c := client.New(testAuthKey, testSecretKey,
+		client.WithCallbackIPWhitelist([]string{"10.0.0.0/24"}),
+		client.WithLogger(ml))
+	svc := NewUSDTService(c)
+	cb := &USDTCallback{
+		CryptoPaymentID: "991",
+		TransactionID:   "TXN12345",
+		Amount:          "25.5",
+		Status:          constants.StatusSuccess,
+		Signature:       md5Hex("991" + "25.50" + "TXN12345" + "1" + testSecretKey),
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.7:55000"); err != nil {
+		t.Fatalf("VerifyCallbackWithIP: %v", err)
+	}
+	if !ml.hasMsg("debug", i18n.Get(i18n.English, i18n.LogVerifyingUSDTCallback)) {
+		t.Error("no debug entry for callback verification start")
+	}
+	if !ml.hasMsg("info", i18n.Get(i18n.English, i18n.LogUSDTCallbackVerified)) {
+		t.Error("no info entry for verified callback")
+	}
+
+	if err := svc.VerifyCallbackWithIP(cb, "192.168.1.1:55000"); err == nil {
+		t.Fatal("unauthorized IP: want error, got nil")
+	}
+	if !ml.hasMsg("warn", i18n.Get(i18n.English, i18n.LogUSDTCallbackIPFailed)) {
+		t.Error("no warning entry for rejected source IP")
+	}
+}
+
+func TestUSDTServiceCreateNetworkSelectsEndpoint(t *testing.T) {
+	cases := []struct {
+		network  USDTNetwork
+		wantPath string
+	}{
+		{"", "/trc20/"},
+		{NetworkTRC20, "/trc20/"},
+		{NetworkERC20, "/erc20/"},
+		{"ERC20", "/erc20/"},
+	}
+	for _, tc := range cases {
+		var gotPath string
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			w.Write(envelope(t, map[string]any{
+				"cryptopayment_id": 12,
+				"transaction_id":   "TXN12345",
+				"amount":           "10.00",
+			}))
+		})
+		svc := NewUSDTService(c)
+		_, err := svc.Create(context.Background(), &USDTRequest{
+			Amount:        10,
+			TransactionID: "TXN12345",
+			Username:      "user1",
+			Network:       tc.network,
+		})
+		if err != nil {
+			t.Fatalf("network %q: Create: %v", tc.network, err)
+		}
+		if !strings.Contains(gotPath, tc.wantPath) {
+			t.Errorf("network %q: path = %q, want containing %q", tc.network, gotPath, tc.wantPath)
+		}
+	}
+}
+
+func TestUSDTRequestValidateRejectsUnknownNetwork(t *testing.T) {
+	req := &USDTRequest{Amount: 10, TransactionID: "TXN12345", Network: "bep20"}
+	mve := req.Validate(i18n.English)
+	if mve == nil || !mve.HasField("network") {
+		t.Fatalf("Validate = %v, want network field error", mve)
+	}
+}
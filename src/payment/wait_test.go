@@ -0,0 +1,75 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func statusEnvelope(t *testing.T, status int) []byte {
+	return envelope(t, map[string]any{
+		"idrpayment_id":  123,
+		"transaction_id": "TXN12345",
+		"amount":         "50000.00",
+		"status":         status,
+		"completed":      status != 0,
+		"success":        status == 1,
+	})
+}
+
+func TestWaitForCompletionReachesTerminalStatus(t *testing.T) {
+	old := pollFloor
+	pollFloor = 5 * time.Millisecond
+	t.Cleanup(func() { pollFloor = old })
+
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Write(statusEnvelope(t, 0))
+			return
+		}
+		w.Write(statusEnvelope(t, 1))
+	})
+	svc := NewIDRService(c)
+	status, err := svc.WaitForCompletion(context.Background(), "TXN12345", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForCompletion: %v", err)
+	}
+	if !status.Status.IsSuccess() {
+		t.Errorf("Status = %v, want StatusSuccess", status.Status)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("polled %d times, want 3", calls.Load())
+	}
+}
+
+func TestWaitForCompletionTimeout(t *testing.T) {
+	old := pollFloor
+	pollFloor = 10 * time.Millisecond
+	t.Cleanup(func() { pollFloor = old })
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(statusEnvelope(t, 0))
+	})
+	svc := NewIDRService(c)
+	ctx, cancel := context.WithTimeout(context.Background(), 35*time.Millisecond)
+	defer cancel()
+	_, err := svc.WaitForCompletion(ctx, "TXN12345", time.Millisecond)
+	if err == nil {
+		t.Fatal("WaitForCompletion returned nil error after deadline")
+	}
+	var wte *WaitTimeoutError
+	if !errors.As(err, &wte) {
+		t.Fatalf("error = %T, want *WaitTimeoutError", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error does not wrap context.DeadlineExceeded: %v", err)
+	}
+	if wte.LastStatus() == nil || !wte.LastStatus().Status.IsPending() {
+		t.Errorf("LastStatus = %+v, want pending status", wte.LastStatus())
+	}
+}
@@ -0,0 +1,62 @@
+package payout
+
+import "fmt"
+
+// Typed accessors over the json.Number fields of callbacks and status
+// responses, so callers do not have to convert and wrap errors themselves.
+
+// AmountFloat64 returns the callback amount as a float64.
+func (cb *IDRCallback) AmountFloat64() (float64, error) {
+	f, err := cb.Amount.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("gspay: parse callback amount %q: %w", cb.Amount, err)
+	}
+	return f, nil
+}
+
+// AmountInt64 returns the callback amount as an int64. It fails for
+// amounts with a fractional part.
+func (cb *IDRCallback) AmountInt64() (int64, error) {
+	n, err := cb.Amount.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("gspay: parse callback amount %q: %w", cb.Amount, err)
+	}
+	return n, nil
+}
+
+// IDRPayoutIDInt64 returns the GSPAY2 payout ID as an int64.
+func (cb *IDRCallback) IDRPayoutIDInt64() (int64, error) {
+	n, err := cb.IDRPayoutID.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("gspay: parse idrpayout_id %q: %w", cb.IDRPayoutID, err)
+	}
+	return n, nil
+}
+
+// AmountFloat64 returns the status amount as a float64.
+func (s *IDRStatusResponse) AmountFloat64() (float64, error) {
+	f, err := s.Amount.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("gspay: parse status amount %q: %w", s.Amount, err)
+	}
+	return f, nil
+}
+
+// AmountInt64 returns the status amount as an int64. It fails for amounts
+// with a fractional part.
+func (s *IDRStatusResponse) AmountInt64() (int64, error) {
+	n, err := s.Amount.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("gspay: parse status amount %q: %w", s.Amount, err)
+	}
+	return n, nil
+}
+
+// IDRPayoutIDInt64 returns the GSPAY2 payout ID as an int64.
+func (s *IDRStatusResponse) IDRPayoutIDInt64() (int64, error) {
+	n, err := s.IDRPayoutID.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("gspay: parse idrpayout_id %q: %w", s.IDRPayoutID, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,43 @@
+package payout
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIDRPayoutCallbackTypedAccessors(t *testing.T) {
+	cb := &IDRCallback{
+		IDRPayoutID: json.Number("77"),
+		Amount:      json.Number("50000"),
+	}
+	if n, err := cb.AmountInt64(); err != nil || n != 50000 {
+		t.Errorf("AmountInt64 = %d, %v, want 50000, nil", n, err)
+	}
+	if f, err := cb.AmountFloat64(); err != nil || f != 50000 {
+		t.Errorf("AmountFloat64 = %v, %v, want 50000, nil", f, err)
+	}
+	if n, err := cb.IDRPayoutIDInt64(); err != nil || n != 77 {
+		t.Errorf("IDRPayoutIDInt64 = %d, %v, want 77, nil", n, err)
+	}
+}
+
+func TestIDRPayoutStatusTypedAccessors(t *testing.T) {
+	status := &IDRStatusResponse{
+		IDRPayoutID: json.Number("88"),
+		Amount:      json.Number("50000.50"),
+	}
+	if f, err := status.AmountFloat64(); err != nil || f != 50000.50 {
+		t.Errorf("AmountFloat64 = %v, %v, want 50000.50, nil", f, err)
+	}
+	if _, err := status.AmountInt64(); err == nil {
+		t.Error("AmountInt64 on a decimal amount succeeded, want error")
+	}
+	if n, err := status.IDRPayoutIDInt64(); err != nil || n != 88 {
+		t.Errorf("IDRPayoutIDInt64 = %d, %v, want 88, nil", n, err)
+	}
+
+	overflow := &IDRStatusResponse{Amount: json.Number("92233720368547758080")}
+	if _, err := overflow.AmountInt64(); err == nil {
+		t.Error("AmountInt64 on an overflowing amount succeeded, want error")
+	}
+}
@@ -0,0 +1,68 @@
+package payout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+// DefaultBatchConcurrency is the number of parallel workers BatchCreate
+// uses unless overridden with WithBatchConcurrency.
+const DefaultBatchConcurrency = 5
+
+// IDRBatchResult reports the outcome of one request in a BatchCreate call.
+type IDRBatchResult struct {
+	// Index is the position of the request in the input slice.
+	Index int
+	// Response is set when the payout was created successfully.
+	Response *IDRResponse
+	// Err is set when this item failed.
+	Err error
+}
+
+// BatchCreate submits multiple payouts concurrently using a worker pool.
+// All requests are validated up front; if any fail, an *errors.ErrorGroup
+// describing every invalid item is returned and nothing is sent. The
+// returned error is otherwise only non-nil for total failure such as
+// context cancellation — per-item failures are reported in the results.
+func (s *IDRService) BatchCreate(ctx context.Context, reqs []*IDRRequest) ([]*IDRBatchResult, error) {
+	group := errors.NewErrorGroup()
+	for i, req := range reqs {
+		if err := s.validateCreate(req); err != nil {
+			group.Add(fmt.Errorf("request %d: %w", i, err))
+		}
+	}
+	if group.HasErrors() {
+		return nil, group
+	}
+
+	results := make([]*IDRBatchResult, len(reqs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < s.batchConcurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					results[i] = &IDRBatchResult{Index: i, Err: err}
+					continue
+				}
+				resp, err := s.Create(ctx, reqs[i])
+				results[i] = &IDRBatchResult{Index: i, Response: resp, Err: err}
+			}
+		}()
+	}
+	for i := range reqs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+	return results, nil
+}
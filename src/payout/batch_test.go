@@ -0,0 +1,128 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func batchRequest(transactionID string) *IDRRequest {
+	return &IDRRequest{
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		BankCode:      "BCA",
+		Amount:        50000,
+		TransactionID: transactionID,
+		Username:      "user1",
+	}
+}
+
+func TestBatchCreatePartialSuccess(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			TransactionID string `json:"transaction_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.TransactionID == "TXN-FAIL" {
+			http.Error(w, `{"code":400,"message":"rejected"}`, http.StatusBadRequest)
+			return
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"transaction_id": body.TransactionID,
+			"amount":         "50000.00",
+			"status":         0,
+		}))
+	})
+	svc := NewIDRService(c, WithBatchConcurrency(2))
+	results, err := svc.BatchCreate(context.Background(), []*IDRRequest{
+		batchRequest("TXN-OK-1"),
+		batchRequest("TXN-FAIL"),
+		batchRequest("TXN-OK-2"),
+	})
+	if err != nil {
+		t.Fatalf("BatchCreate: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("len(results) = %d, want 3", len(results))
+	}
+	if results[0].Err != nil || results[2].Err != nil {
+		t.Errorf("successful items carry errors: %v, %v", results[0].Err, results[2].Err)
+	}
+	if results[1].Err == nil {
+		t.Error("failed item carries no error")
+	}
+	if results[1].Index != 1 {
+		t.Errorf("results[1].Index = %d, want 1", results[1].Index)
+	}
+}
+
+func TestBatchCreateValidationCollectsAllErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should reach the server when validation fails")
+	})
+	svc := NewIDRService(c)
+	bad1 := batchRequest("TXN-BAD1")
+	bad1.BankCode = "NOTABANK"
+	bad2 := batchRequest("TXN-BAD2")
+	bad2.Amount = 1
+	_, err := svc.BatchCreate(context.Background(), []*IDRRequest{bad1, bad2, batchRequest("TXN-OK-1")})
+	if err == nil {
+		t.Fatal("BatchCreate returned nil error for invalid batch")
+	}
+	group := errors.GetErrorGroup(err)
+	if group == nil {
+		t.Fatalf("error = %v, want *ErrorGroup", err)
+	}
+	fields := map[string]bool{}
+	for _, e := range group.Errors() {
+		if ve := errors.GetValidationError(e); ve != nil {
+			fields[ve.Field] = true
+		}
+	}
+	if !fields["bank_code"] || !fields["amount"] {
+		t.Errorf("error group fields = %v, want bank_code and amount", fields)
+	}
+}
+
+func TestBatchCreateContextCancellation(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(50 * time.Millisecond)
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         0,
+		}))
+	})
+	svc := NewIDRService(c, WithBatchConcurrency(1))
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(25 * time.Millisecond)
+		cancel()
+	}()
+	reqs := []*IDRRequest{
+		batchRequest("TXN-OK-1"),
+		batchRequest("TXN-OK-2"),
+		batchRequest("TXN-OK-3"),
+	}
+	results, err := svc.BatchCreate(ctx, reqs)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+	if len(results) != len(reqs) {
+		t.Fatalf("len(results) = %d, want %d", len(results), len(reqs))
+	}
+	if got := calls.Load(); got >= int32(len(reqs)) {
+		t.Errorf("server received %d calls, want fewer than %d after cancellation", got, len(reqs))
+	}
+}
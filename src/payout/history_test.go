@@ -0,0 +1,95 @@
+package payout
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+func TestHistoryRequestValidate(t *testing.T) {
+	valid := &HistoryRequest{Page: 1, PageSize: 50}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid request: Validate = %v, want nil", err)
+	}
+	for _, tt := range []struct {
+		name string
+		req  *HistoryRequest
+	}{
+		{"zero page", &HistoryRequest{Page: 0, PageSize: 50}},
+		{"zero page size", &HistoryRequest{Page: 1, PageSize: 0}},
+		{"oversized page", &HistoryRequest{Page: 1, PageSize: constants.MaxHistoryPageSize + 1}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(i18n.English); errors.GetValidationError(err) == nil {
+				t.Errorf("Validate = %v, want ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestIDRServiceGetHistory(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("page") != "2" || q.Get("page_size") != "25" {
+			t.Errorf("pagination query = %v, want page=2 page_size=25", q)
+		}
+		if q.Has("status") {
+			t.Errorf("status = %q, want omitted for nil filter", q.Get("status"))
+		}
+		w.Write(envelope(t, map[string]any{
+			"items": []map[string]any{
+				{"idrpayout_id": 77, "transaction_id": "TXN12345", "amount": "50000.00", "status": 1},
+			},
+			"total_count": 51,
+			"page":        2,
+			"page_size":   25,
+			"has_more":    true,
+		}))
+	})
+	svc := NewIDRService(c)
+	resp, err := svc.GetHistory(context.Background(), &HistoryRequest{Page: 2, PageSize: 25})
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].TransactionID != "TXN12345" {
+		t.Errorf("Items = %+v, want one item TXN12345", resp.Items)
+	}
+	if !resp.HasMore || resp.TotalCount != 51 {
+		t.Errorf("HasMore = %v, TotalCount = %d, want true, 51", resp.HasMore, resp.TotalCount)
+	}
+}
+
+func TestIDRServiceGetHistoryFilters(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("status") != "1" {
+			t.Errorf("status = %q, want 1", q.Get("status"))
+		}
+		if q.Get("from") != "2026-01-01T00:00:00Z" {
+			t.Errorf("from = %q, want 2026-01-01T00:00:00Z", q.Get("from"))
+		}
+		if q.Get("to") != "2026-01-31T23:59:59Z" {
+			t.Errorf("to = %q, want 2026-01-31T23:59:59Z", q.Get("to"))
+		}
+		w.Write(envelope(t, map[string]any{"items": []map[string]any{}, "page": 1, "page_size": 10}))
+	})
+	svc := NewIDRService(c)
+	status := constants.StatusSuccess
+	_, err := svc.GetHistory(context.Background(), &HistoryRequest{
+		Page:     1,
+		PageSize: 10,
+		Status:   &status,
+		From:     &from,
+		To:       &to,
+	})
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+package payout
+
+import "context"
+
+// Hooks bundles the lifecycle callbacks an IDRService fires so operators
+// can attach side effects — audit logs, database writes, metrics —
+// without wrapping every SDK call. Hooks run synchronously before the
+// method returns and are not recovered: a panicking hook propagates to
+// the caller. Nil fields are no-ops.
+type Hooks struct {
+	// OnPayoutCreated fires after Create succeeds.
+	OnPayoutCreated func(context.Context, *IDRResponse)
+	// OnCallbackVerified fires after VerifyCallbackWithIP accepts a callback.
+	OnCallbackVerified func(context.Context, *IDRCallback)
+	// OnCallbackFailed fires when VerifyCallbackWithIP rejects a callback.
+	OnCallbackFailed func(context.Context, error)
+	// OnStatusRetrieved fires after GetStatus succeeds.
+	OnStatusRetrieved func(context.Context, *IDRStatusResponse)
+}
+
+// WithHooks attaches lifecycle hooks to an IDRService.
+func WithHooks(h Hooks) IDRServiceOption {
+	return func(s *IDRService) { s.hooks = h }
+}
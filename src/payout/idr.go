@@ -0,0 +1,429 @@
+// Package payout implements the GSPAY2 payout (disbursement) services for
+// the supported currencies.
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/balance"
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/helper/amount"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+	"github.com/nikon1313/gspay-go-sdk/src/internal/flexjson"
+)
+
+// IDRService creates and tracks Indonesian Rupiah payouts to bank accounts
+// and e-wallets.
+type IDRService struct {
+	client           client.Doer
+	batchConcurrency int
+	hooks            Hooks
+	dedup            Deduplicator
+	balanceSvc       *balance.Service
+}
+
+// IDRServiceOption configures an IDRService during construction.
+type IDRServiceOption func(*IDRService)
+
+// WithBatchConcurrency sets the number of parallel workers used by
+// BatchCreate. Values below one are ignored.
+func WithBatchConcurrency(n int) IDRServiceOption {
+	return func(s *IDRService) {
+		if n >= 1 {
+			s.batchConcurrency = n
+		}
+	}
+}
+
+// WithBalanceService injects a balance service used for the optional
+// balance pre-flight check on requests with CheckBalance set.
+func WithBalanceService(svc *balance.Service) IDRServiceOption {
+	return func(s *IDRService) { s.balanceSvc = svc }
+}
+
+// NewIDRService returns an IDRService backed by the given client.
+func NewIDRService(c client.Doer, opts ...IDRServiceOption) *IDRService {
+	s := &IDRService{client: c, batchConcurrency: DefaultBatchConcurrency}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// IDRRequest describes a new IDR payout.
+type IDRRequest struct {
+	// AccountName is the beneficiary account holder name.
+	AccountName string
+	// AccountNumber is the beneficiary account or e-wallet number.
+	AccountNumber string
+	// BankCode selects the receiving bank and must be a key of
+	// constants.BanksIDR (case-insensitive).
+	BankCode string
+	// Amount is the payout amount in whole Rupiah.
+	Amount int64
+	// TransactionID is the operator's unique reference.
+	TransactionID string
+	// Username identifies the receiving end user on the operator's side.
+	Username string
+	// CheckBalance enables a best-effort pre-flight balance check before
+	// the payout is submitted. It requires a balance service injected with
+	// WithBalanceService and is off by default.
+	CheckBalance bool
+}
+
+// IDRResponse is the payload returned when an IDR payout is created.
+type IDRResponse struct {
+	IDRPayoutID   json.Number             `json:"idrpayout_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        string                  `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+}
+
+// IDRStatusResponse is the payload returned by GetStatus.
+type IDRStatusResponse struct {
+	IDRPayoutID   json.Number             `json:"idrpayout_id"`
+	AccountNumber string                  `json:"account_number"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Completed     bool                    `json:"completed"`
+	PayoutSuccess bool                    `json:"payout_success"`
+	Remark        string                  `json:"remark"`
+	Signature     string                  `json:"signature"`
+}
+
+// IDRCallback is the JSON body GSPAY2 posts to the operator's callback URL
+// when a payout reaches a final state.
+type IDRCallback struct {
+	IDRPayoutID   json.Number             `json:"idrpayout_id"`
+	AccountNumber string                  `json:"account_number"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Signature     string                  `json:"signature"`
+}
+
+// UnmarshalJSON decodes the callback tolerantly, accepting identifiers
+// and amounts as JSON numbers or quoted strings and the status as an
+// integer or string integer. A value of the wrong type is rejected with
+// an error naming the field.
+func (cb *IDRCallback) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var err error
+	if cb.IDRPayoutID, err = flexjson.Number("idrpayout_id", fields["idrpayout_id"]); err != nil {
+		return err
+	}
+	if cb.AccountNumber, err = flexjson.String("account_number", fields["account_number"]); err != nil {
+		return err
+	}
+	if cb.TransactionID, err = flexjson.String("transaction_id", fields["transaction_id"]); err != nil {
+		return err
+	}
+	if cb.Amount, err = flexjson.Number("amount", fields["amount"]); err != nil {
+		return err
+	}
+	status, err := flexjson.Int("status", fields["status"])
+	if err != nil {
+		return err
+	}
+	cb.Status = constants.PaymentStatus(status)
+	cb.Signature, err = flexjson.String("signature", fields["signature"])
+	return err
+}
+
+// Validate checks the callback fields structurally, without touching
+// cryptography: the transaction ID must be within length bounds, the
+// account number non-empty and the amount a non-negative decimal. It
+// returns a *errors.ValidationError or an *errors.ErrorGroup.
+func (cb *IDRCallback) Validate(lang i18n.Language) error {
+	g := errors.NewErrorGroup()
+	g.Lang = lang
+	if l := len(cb.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		g.Add(errors.NewValidationError(lang, "transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if cb.AccountNumber == "" {
+		g.Add(errors.NewValidationError(lang, "account_number", i18n.MsgRequiredField))
+	}
+	if f, err := strconv.ParseFloat(cb.Amount.String(), 64); err != nil {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmountFormat, errors.ErrInvalidAmount))
+	} else if f < 0 {
+		g.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	return g.Err()
+}
+
+type idrCreateRequest struct {
+	AccountName   string `json:"account_name"`
+	AccountNumber string `json:"account_number"`
+	BankCode      string `json:"bank_code"`
+	Amount        int64  `json:"amount"`
+	TransactionID string `json:"transaction_id"`
+	Username      string `json:"username"`
+}
+
+// Validate checks the request fields without making a network call. It
+// returns a *errors.MultiValidationError listing every invalid field, or
+// nil when the request is valid.
+func (r *IDRRequest) Validate(lang i18n.Language) *errors.MultiValidationError {
+	m := errors.NewMultiValidationError(lang)
+	if r.AccountName == "" {
+		m.Add(errors.NewValidationError(lang, "account_name", i18n.MsgRequiredField))
+	}
+	if r.AccountNumber == "" {
+		m.Add(errors.NewValidationError(lang, "account_number", i18n.MsgRequiredField))
+	}
+	if r.Username == "" {
+		m.Add(errors.NewValidationError(lang, "username", i18n.MsgRequiredField))
+	}
+	if l := len(r.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		m.Add(errors.NewValidationError(lang, "transaction_id", i18n.MsgInvalidTransactionID, errors.ErrInvalidTransactionID))
+	}
+	if !constants.IsValidBankIDR(r.BankCode) {
+		m.Add(errors.NewValidationError(lang, "bank_code", i18n.MsgInvalidBankCode, errors.ErrInvalidBankCode))
+	}
+	if r.Amount < constants.MinAmountIDR {
+		m.Add(errors.NewValidationError(lang, "amount", i18n.MsgInvalidAmount, errors.ErrInvalidAmount))
+	}
+	return m.Err()
+}
+
+// validateCreate runs the pre-flight checks shared by Create and
+// BatchCreate.
+func (s *IDRService) validateCreate(req *IDRRequest) error {
+	if mve := req.Validate(s.client.Lang()); mve != nil {
+		return mve
+	}
+	return nil
+}
+
+// Create submits a new IDR payout.
+func (s *IDRService) Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payout.idr.create")
+	defer span.End()
+	if err := s.validateCreate(req); err != nil {
+		return nil, err
+	}
+	if req.CheckBalance {
+		if err := s.checkBalance(ctx, req.Amount); err != nil {
+			return nil, err
+		}
+	}
+	bankCode := strings.ToUpper(req.BankCode)
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingIDRPayout),
+		"transactionID", req.TransactionID,
+		"amount", req.Amount,
+		"bankCode", bankCode,
+		"accountNumber", s.client.LogAccountNumber(req.AccountNumber),
+		"accountName", s.client.LogAccountName(req.AccountName))
+	endpoint := s.client.Endpoint(constants.EndpointPayoutIDRCreate)
+	var resp IDRResponse
+	if err := s.client.Post(ctx, endpoint, &idrCreateRequest{
+		AccountName:   req.AccountName,
+		AccountNumber: req.AccountNumber,
+		BankCode:      bankCode,
+		Amount:        req.Amount,
+		TransactionID: req.TransactionID,
+		Username:      req.Username,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPayoutCreated),
+		"transactionID", resp.TransactionID,
+		"payoutID", resp.IDRPayoutID.String())
+	if s.hooks.OnPayoutCreated != nil {
+		s.hooks.OnPayoutCreated(ctx, &resp)
+	}
+	return &resp, nil
+}
+
+// checkBalance verifies that the settlement balance covers amount. The
+// check is best-effort: a failing balance call logs a warning and lets
+// the payout proceed.
+func (s *IDRService) checkBalance(ctx context.Context, amount int64) error {
+	if s.balanceSvc == nil {
+		return nil
+	}
+	resp, err := s.balanceSvc.GetAll(ctx)
+	if err != nil {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogBalanceCheckFailed), "error", err)
+		return nil
+	}
+	if float64(amount) > resp.Balance {
+		return s.client.Error(errors.ErrInsufficientBalance, fmt.Sprintf("available %.2f", resp.Balance))
+	}
+	return nil
+}
+
+// GetStatus fetches the current status of a payout by the operator's
+// transaction ID. Callers should verify the returned value with
+// VerifyStatusSignature before acting on it.
+func (s *IDRService) GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error) {
+	return s.getStatus(ctx, "gspay.payout.idr.get_status", constants.EndpointPayoutIDRStatus,
+		"transaction_id="+url.QueryEscape(transactionID), "transactionID", transactionID)
+}
+
+// GetStatusByPayoutID fetches the current status of a payout by GSPAY2's
+// own idrpayout_id, for cases where the operator transaction ID has been
+// lost or corrupted.
+func (s *IDRService) GetStatusByPayoutID(ctx context.Context, payoutID string) (*IDRStatusResponse, error) {
+	return s.getStatus(ctx, "gspay.payout.idr.get_status_by_id", constants.EndpointPayoutIDRStatusByID,
+		"idrpayout_id="+url.QueryEscape(payoutID), "payoutID", payoutID)
+}
+
+// getStatus performs the status fetch shared by GetStatus and
+// GetStatusByPayoutID. rawQuery must already be URL-encoded.
+func (s *IDRService) getStatus(ctx context.Context, spanName, endpointName, rawQuery, logKey, logValue string) (*IDRStatusResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, spanName)
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingIDRPayoutStatus),
+		logKey, logValue)
+	endpoint := s.client.Endpoint(endpointName) + "?" + rawQuery
+	var status IDRStatusResponse
+	if err := s.client.Get(ctx, endpoint, &status); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPayoutStatusRetrieved),
+		"transactionID", status.TransactionID,
+		"accountNumber", s.client.LogAccountNumber(status.AccountNumber),
+		"status", status.Status.String())
+	if s.hooks.OnStatusRetrieved != nil {
+		s.hooks.OnStatusRetrieved(ctx, &status)
+	}
+	return &status, nil
+}
+
+// VerifySignature checks a payout signature built from the raw field
+// values: MD5(idrpayout_id + account_number + amount + transaction_id +
+// secret_key), where amount is normalized to two decimal places.
+func (s *IDRService) VerifySignature(payoutID, accountNumber, amountStr, transactionID, signature string) error {
+	if payoutID == "" || accountNumber == "" || amountStr == "" || transactionID == "" || signature == "" {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutCallbackSignatureFailed),
+			"transactionID", transactionID, "reason", "missing field")
+		return s.client.Error(errors.ErrMissingCallbackField)
+	}
+	formatted, err := amount.ParseAmountStrict(amountStr, s.client.Lang())
+	if err != nil {
+		return err
+	}
+	if !s.client.VerifySignature(signature, payoutID, accountNumber, formatted, transactionID) {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutCallbackSignatureFailed),
+			"transactionID", transactionID, "payoutID", payoutID)
+		return s.client.Error(errors.ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// VerifyStatusSignature checks the signature on a GetStatus response,
+// using the same formula as the callback signature.
+func (s *IDRService) VerifyStatusSignature(status *IDRStatusResponse) error {
+	return s.VerifySignature(
+		status.IDRPayoutID.String(),
+		status.AccountNumber,
+		status.Amount.String(),
+		status.TransactionID,
+		status.Signature,
+	)
+}
+
+// VerifySignatureFromStatusResponse verifies the signature on a GetStatus
+// response after cross-checking the fields against each other: a success
+// status must be marked completed, and the PayoutSuccess flag must agree
+// with the status. Contradictory fields fail with
+// errors.ErrInconsistentCallback before any cryptography runs.
+func (s *IDRService) VerifySignatureFromStatusResponse(status *IDRStatusResponse) error {
+	if status.Status.IsSuccess() != status.PayoutSuccess {
+		return s.client.Error(errors.ErrInconsistentCallback,
+			fmt.Sprintf("status %s with payout_success=%t", status.Status, status.PayoutSuccess))
+	}
+	if status.Status.IsSuccess() && !status.Completed {
+		return s.client.Error(errors.ErrInconsistentCallback, "success status without completed")
+	}
+	return s.VerifyStatusSignature(status)
+}
+
+// VerifyCallback validates the callback structurally and then checks its
+// signature. Callers that know the source address should prefer
+// VerifyCallbackWithIP.
+func (s *IDRService) VerifyCallback(cb *IDRCallback) error {
+	if err := cb.Validate(s.client.Lang()); err != nil {
+		return err
+	}
+	if err := s.VerifySignature(
+		cb.IDRPayoutID.String(),
+		cb.AccountNumber,
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Signature,
+	); err != nil {
+		return err
+	}
+	return s.dedupCheck(cb.TransactionID)
+}
+
+// VerifyCallbackWithIP checks the callback source address against the
+// configured whitelist and then verifies the callback signature.
+func (s *IDRService) VerifyCallbackWithIP(cb *IDRCallback, remoteAddr string) error {
+	s.client.Logger().Debug(s.client.I18n(i18n.LogVerifyingIDRPayoutCallback),
+		"transactionID", cb.TransactionID,
+		"payoutID", cb.IDRPayoutID.String(),
+		"sourceIP", remoteAddr)
+	if err := s.client.VerifyCallbackIP(remoteAddr); err != nil {
+		s.client.Logger().Warn(s.client.I18n(i18n.LogIDRPayoutCallbackIPFailed),
+			"transactionID", cb.TransactionID,
+			"sourceIP", remoteAddr)
+		if s.hooks.OnCallbackFailed != nil {
+			s.hooks.OnCallbackFailed(context.Background(), err)
+		}
+		return err
+	}
+	if err := s.VerifyCallback(cb); err != nil {
+		if s.hooks.OnCallbackFailed != nil {
+			s.hooks.OnCallbackFailed(context.Background(), err)
+		}
+		return err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogIDRPayoutCallbackVerified),
+		"transactionID", cb.TransactionID,
+		"payoutID", cb.IDRPayoutID.String())
+	if s.hooks.OnCallbackVerified != nil {
+		s.hooks.OnCallbackVerified(context.Background(), cb)
+	}
+	return nil
+}
+
+// ParseCallbackFromRequest decodes a IDR payout callback from an incoming
+// webhook request. It enforces the POST method, the application/json
+// content type and the client's maximum callback body size, and returns
+// the decoded callback without verifying its signature.
+func (s *IDRService) ParseCallbackFromRequest(r *http.Request) (*IDRCallback, error) {
+	var cb IDRCallback
+	if err := s.client.ParseCallbackBody(r, &cb); err != nil {
+		return nil, err
+	}
+	return &cb, nil
+}
+
+// ParseAndVerifyCallbackFromRequest decodes a IDR payout callback and then
+// verifies its source IP and signature. It is the recommended entry point
+// for webhook handlers.
+func (s *IDRService) ParseAndVerifyCallbackFromRequest(r *http.Request) (*IDRCallback, error) {
+	cb, err := s.ParseCallbackFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.VerifyCallbackWithIP(cb, r.RemoteAddr); err != nil {
+		return nil, err
+	}
+	return cb, nil
+}
@@ -0,0 +1,596 @@
+package payout
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/balance"
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+const (
+	testAuthKey   = "auth-key"
+	testSecretKey = "secret-key"
+)
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// mockLogger records log calls so tests can assert on messages and
+// key-value pairs.
+type mockLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+type logEntry struct {
+	level string
+	msg   string
+	kvs   []any
+}
+
+func (m *mockLogger) record(level, msg string, kvs []any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, logEntry{level: level, msg: msg, kvs: kvs})
+}
+
+func (m *mockLogger) Debug(msg string, kvs ...any) { m.record("debug", msg, kvs) }
+func (m *mockLogger) Info(msg string, kvs ...any)  { m.record("info", msg, kvs) }
+func (m *mockLogger) Warn(msg string, kvs ...any)  { m.record("warn", msg, kvs) }
+func (m *mockLogger) Error(msg string, kvs ...any) { m.record("error", msg, kvs) }
+
+// hasMsg reports whether an entry with the level and message was logged.
+func (m *mockLogger) hasMsg(level, msg string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.entries {
+		if e.level == level && e.msg == msg {
+			return true
+		}
+	}
+	return false
+}
+
+// hasKV reports whether any recorded entry carries the key-value pair.
+func (m *mockLogger) hasKV(key string, value any) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.entries {
+		for i := 0; i+1 < len(e.kvs); i += 2 {
+			if e.kvs[i] == key && fmt.Sprint(e.kvs[i+1]) == fmt.Sprint(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...client.Option) *client.Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	opts = append([]client.Option{
+		client.WithBaseURL(srv.URL),
+		client.WithRetries(0),
+	}, opts...)
+	return client.New(testAuthKey, testSecretKey, opts...)
+}
+
+func envelope(t *testing.T, data any) []byte {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]any{"code": 200, "message": "OK", "data": json.RawMessage(raw)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestIDRServiceCreateValidation(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	_, err := svc.Create(context.Background(), &IDRRequest{
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		BankCode:      "NOTABANK",
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if ve := errors.GetValidationError(err); ve == nil || ve.Field != "bank_code" {
+		t.Errorf("unknown bank: error = %v, want ValidationError on bank_code", err)
+	}
+
+	_, err = svc.Create(context.Background(), &IDRRequest{
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		BankCode:      "BCA",
+		Amount:        500,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if ve := errors.GetValidationError(err); ve == nil || ve.Field != "amount" {
+		t.Errorf("low amount: error = %v, want ValidationError on amount", err)
+	}
+}
+
+func TestIDRRequestValidateRequiredFields(t *testing.T) {
+	valid := &IDRRequest{
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		BankCode:      "BCA",
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid request: Validate = %v, want nil", err)
+	}
+
+	mve := (&IDRRequest{BankCode: "BCA", Amount: 50000, TransactionID: "TXN12345"}).Validate(i18n.English)
+	if mve == nil {
+		t.Fatal("Validate = nil, want *MultiValidationError")
+	}
+	for _, want := range []string{"account_name", "account_number", "username"} {
+		if !mve.HasField(want) {
+			t.Errorf("Validate is missing a ValidationError for %s (got %v)", want, mve.Fields())
+		}
+	}
+}
+
+func TestIDRRequestValidateTransactionID(t *testing.T) {
+	for _, txid := range []string{"abc", "a-transaction-id-longer-than-twenty"} {
+		err := (&IDRRequest{
+			AccountName:   "John Doe",
+			AccountNumber: "1234567890",
+			BankCode:      "BCA",
+			Amount:        50000,
+			TransactionID: txid,
+			Username:      "user1",
+		}).Validate(i18n.English)
+		if ve := errors.GetValidationError(err); ve == nil || ve.Field != "transaction_id" {
+			t.Errorf("transaction ID %q: error = %v, want ValidationError on transaction_id", txid, err)
+		}
+	}
+}
+
+func TestIDRServiceCreateLogsSanitized(t *testing.T) {
+	ml := &mockLogger{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         0,
+		}))
+	}, client.WithLogger(ml))
+	svc := NewIDRService(c)
+	_, err := svc.Create(context.Background(), &IDRRequest{
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		BankCode:      "bca",
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !ml.hasKV("accountNumber", "****7890") {
+		t.Error("Create log does not carry the masked account number ****7890")
+	}
+	if !ml.hasKV("accountName", "J*** D***") {
+		t.Error("Create log does not carry the masked account name J*** D***")
+	}
+	if ml.hasKV("accountNumber", "1234567890") {
+		t.Error("Create log leaks the raw account number")
+	}
+}
+
+func TestIDRServiceGetStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("transaction_id"); got != "TXN12345" {
+			t.Errorf("transaction_id = %q, want TXN12345", got)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"account_number": "1234567890",
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         1,
+			"completed":      true,
+			"payout_success": true,
+		}))
+	})
+	svc := NewIDRService(c)
+	status, err := svc.GetStatus(context.Background(), "TXN12345")
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if status.Status != constants.StatusSuccess {
+		t.Errorf("Status = %v, want StatusSuccess", status.Status)
+	}
+	if !status.PayoutSuccess {
+		t.Error("PayoutSuccess = false, want true")
+	}
+}
+
+func TestIDRServiceVerifyCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	cb := &IDRCallback{
+		IDRPayoutID:   "77",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("77" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Fatalf("VerifyCallback: %v", err)
+	}
+
+	cb.Signature = md5Hex("bad")
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+
+	cb.Signature = ""
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrMissingCallbackField) {
+		t.Fatalf("error = %v, want ErrMissingCallbackField", err)
+	}
+}
+
+func TestIDRServiceVerifyCallbackWithIP(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey,
+		client.WithCallbackIPWhitelist([]string{"10.0.0.0/24"}))
+	svc := NewIDRService(c)
+	cb := &IDRCallback{
+		IDRPayoutID:   "77",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("77" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.7:55000"); err != nil {
+		t.Fatalf("whitelisted IP: %v", err)
+	}
+	err := svc.VerifyCallbackWithIP(cb, "192.168.1.1:55000")
+	if !errors.Is(err, errors.ErrUnauthorizedIP) {
+		t.Fatalf("error = %v, want ErrUnauthorizedIP", err)
+	}
+	if !strings.Contains(err.Error(), "192.168.1.1") {
+		t.Errorf("error %q does not name the rejected IP", err)
+	}
+}
+
+func TestIDRServiceParseAndVerifyCallbackFromRequest(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+
+	sig := md5Hex("77" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey)
+	body := `{"idrpayout_id":77,"account_number":"1234567890","transaction_id":"TXN12345","amount":"50000","status":1,"signature":"` + sig + `"}`
+	r := httptest.NewRequest("POST", "/callback", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	cb, err := svc.ParseAndVerifyCallbackFromRequest(r)
+	if err != nil {
+		t.Fatalf("ParseAndVerifyCallbackFromRequest: %v", err)
+	}
+	if cb.TransactionID != "TXN12345" {
+		t.Errorf("TransactionID = %q, want TXN12345", cb.TransactionID)
+	}
+
+	r = httptest.NewRequest("GET", "/callback", nil)
+	r.Header.Set("Content-Type", "application/json")
+	if _, err := svc.ParseAndVerifyCallbackFromRequest(r); !errors.Is(err, errors.ErrInvalidHTTPMethod) {
+		t.Errorf("GET: error = %v, want ErrInvalidHTTPMethod", err)
+	}
+}
+
+func TestIDRPayoutCallbackValidate(t *testing.T) {
+	valid := &IDRCallback{TransactionID: "TXN12345", AccountNumber: "1234567890", Amount: json.Number("50000")}
+	if err := valid.Validate(i18n.English); err != nil {
+		t.Errorf("valid callback: Validate = %v, want nil", err)
+	}
+
+	invalid := &IDRCallback{TransactionID: "", Amount: json.Number("abc")}
+	err := invalid.Validate(i18n.English)
+	group := errors.GetErrorGroup(err)
+	if group == nil {
+		t.Fatalf("Validate = %v, want *ErrorGroup", err)
+	}
+	fields := map[string]bool{}
+	for _, e := range group.Errors() {
+		if ve := errors.GetValidationError(e); ve != nil {
+			fields[ve.Field] = true
+		}
+	}
+	if !fields["transaction_id"] || !fields["account_number"] || !fields["amount"] {
+		t.Errorf("Validate fields = %v, want transaction_id, account_number and amount", fields)
+	}
+}
+
+func TestIDRServiceGetStatusByPayoutID(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("idrpayout_id"); got != "77" {
+			t.Errorf("idrpayout_id = %q, want 77", got)
+		}
+		if got := r.URL.Query().Get("transaction_id"); got != "" {
+			t.Errorf("transaction_id = %q, want unset", got)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"account_number": "1234567890",
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         1,
+		}))
+	})
+	svc := NewIDRService(c)
+	status, err := svc.GetStatusByPayoutID(context.Background(), "77")
+	if err != nil {
+		t.Fatalf("GetStatusByPayoutID: %v", err)
+	}
+	if status.TransactionID != "TXN12345" {
+		t.Errorf("TransactionID = %q, want TXN12345", status.TransactionID)
+	}
+}
+
+func TestIDRServiceVerifyCallbackWithIPLogs(t *testing.T) {
+	ml := &mockLogger{}
+	c := client.New(testAuthKey, testSecretKey,
+		client.WithCallbackIPWhitelist([]string{"10.0.0.0/24"}),
+		client.WithLogger(ml))
+	svc := NewIDRService(c)
+	cb := &IDRCallback{
+		IDRPayoutID:   "77",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("77" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifyCallbackWithIP(cb, "10.0.0.7:55000"); err != nil {
+		t.Fatalf("VerifyCallbackWithIP: %v", err)
+	}
+	if !ml.hasMsg("debug", i18n.Get(i18n.English, i18n.LogVerifyingIDRPayoutCallback)) {
+		t.Error("no debug entry for callback verification start")
+	}
+	if !ml.hasMsg("info", i18n.Get(i18n.English, i18n.LogIDRPayoutCallbackVerified)) {
+		t.Error("no info entry for verified callback")
+	}
+	if !ml.hasKV("sourceIP", "10.0.0.7:55000") {
+		t.Error("log entries do not carry the source IP")
+	}
+	if !ml.hasKV("payoutID", "77") {
+		t.Error("log entries do not carry the payout ID")
+	}
+
+	if err := svc.VerifyCallbackWithIP(cb, "192.168.1.1:55000"); err == nil {
+		t.Fatal("unauthorized IP: want error, got nil")
+	}
+	if !ml.hasMsg("warn", i18n.Get(i18n.English, i18n.LogIDRPayoutCallbackIPFailed)) {
+		t.Error("no warning entry for rejected source IP")
+	}
+}
+
+func TestIDRServiceGetStatusLogsSanitizedAccountNumber(t *testing.T) {
+	ml := &mockLogger{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"account_number": "1234567890",
+			"transaction_id": "TXN12345",
+			"amount":         "50000",
+			"status":         1,
+		}))
+	}, client.WithLogger(ml))
+	svc := NewIDRService(c)
+	if _, err := svc.GetStatus(context.Background(), "TXN12345"); err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if !ml.hasKV("accountNumber", "****7890") {
+		t.Error("GetStatus log does not carry the masked account number")
+	}
+	if ml.hasKV("accountNumber", "1234567890") {
+		t.Error("GetStatus log leaks the raw account number")
+	}
+}
+
+func TestIDRServiceGetStatusLogsRawAccountNumberInDebug(t *testing.T) {
+	ml := &mockLogger{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   77,
+			"account_number": "1234567890",
+			"transaction_id": "TXN12345",
+			"amount":         "50000",
+			"status":         1,
+		}))
+	}, client.WithLogger(ml), client.WithDebug(true))
+	svc := NewIDRService(c)
+	if _, err := svc.GetStatus(context.Background(), "TXN12345"); err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if !ml.hasKV("accountNumber", "1234567890") {
+		t.Error("debug mode did not log the raw account number")
+	}
+}
+
+func validBalanceCheckRequest() *IDRRequest {
+	return &IDRRequest{
+		AccountName:   "John Doe",
+		AccountNumber: "1234567890",
+		BankCode:      "BCA",
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+		CheckBalance:  true,
+	}
+}
+
+func TestIDRServiceCreateInsufficientBalance(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request; insufficient balance must block the payout", r.Method)
+		}
+		w.Write(envelope(t, map[string]any{"balance": 1000, "usdt_balance": 0}))
+	})
+	svc := NewIDRService(c, WithBalanceService(balance.NewService(c)))
+	_, err := svc.Create(context.Background(), validBalanceCheckRequest())
+	if !errors.Is(err, errors.ErrInsufficientBalance) {
+		t.Errorf("Create error = %v, want ErrInsufficientBalance", err)
+	}
+}
+
+func TestIDRServiceCreateBalanceCheckFailureProceeds(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   55,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         0,
+		}))
+	})
+	svc := NewIDRService(c, WithBalanceService(balance.NewService(c)))
+	resp, err := svc.Create(context.Background(), validBalanceCheckRequest())
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.IDRPayoutID.String() != "55" {
+		t.Errorf("IDRPayoutID = %q, want 55", resp.IDRPayoutID)
+	}
+}
+
+func TestIDRServiceCreateBalanceCheckOffByDefault(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			t.Error("balance queried although CheckBalance is unset")
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   55,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         0,
+		}))
+	})
+	svc := NewIDRService(c, WithBalanceService(balance.NewService(c)))
+	req := validBalanceCheckRequest()
+	req.CheckBalance = false
+	if _, err := svc.Create(context.Background(), req); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+}
+
+func TestIDRServiceCreateAcceptsEWalletCodes(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   56,
+			"transaction_id": "TXN12345",
+			"amount":         "50000.00",
+			"status":         0,
+		}))
+	})
+	svc := NewIDRService(c)
+	req := validBalanceCheckRequest()
+	req.CheckBalance = false
+	req.BankCode = "DANA"
+	if _, err := svc.Create(context.Background(), req); err != nil {
+		t.Fatalf("Create with e-wallet code: %v", err)
+	}
+}
+
+func TestIDRServiceVerifyStatusSignature(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	status := &IDRStatusResponse{
+		IDRPayoutID:   "42",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("42" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifyStatusSignature(status); err != nil {
+		t.Fatalf("VerifyStatusSignature: %v", err)
+	}
+
+	status.Signature = md5Hex("tampered")
+	if err := svc.VerifyStatusSignature(status); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+
+	status.Signature = ""
+	if err := svc.VerifyStatusSignature(status); !errors.Is(err, errors.ErrMissingCallbackField) {
+		t.Fatalf("error = %v, want ErrMissingCallbackField", err)
+	}
+}
+
+func TestIDRServiceVerifyStatusSignatureBadAmount(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	status := &IDRStatusResponse{
+		IDRPayoutID:   "42",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "fifty thousand",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("whatever"),
+	}
+	var verr *errors.ValidationError
+	if err := svc.VerifyStatusSignature(status); !errors.As(err, &verr) || verr.Field != "amount" {
+		t.Fatalf("error = %v, want a validation error on the amount field", err)
+	}
+}
+
+func TestIDRServiceVerifySignatureFromStatusResponse(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewIDRService(c)
+	status := &IDRStatusResponse{
+		IDRPayoutID:   "42",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "50000",
+		Status:        constants.StatusSuccess,
+		Completed:     true,
+		PayoutSuccess: true,
+		Signature:     md5Hex("42" + "1234567890" + "50000.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifySignatureFromStatusResponse(status); err != nil {
+		t.Fatalf("VerifySignatureFromStatusResponse: %v", err)
+	}
+
+	status.Completed = false
+	if err := svc.VerifySignatureFromStatusResponse(status); !errors.Is(err, errors.ErrInconsistentCallback) {
+		t.Errorf("success without completed: error = %v, want ErrInconsistentCallback", err)
+	}
+
+	status.Completed = true
+	status.PayoutSuccess = false
+	if err := svc.VerifySignatureFromStatusResponse(status); !errors.Is(err, errors.ErrInconsistentCallback) {
+		t.Errorf("success status with payout_success=false: error = %v, want ErrInconsistentCallback", err)
+	}
+}
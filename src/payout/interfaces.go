@@ -0,0 +1,41 @@
+package payout
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// IDRServicer captures the exported methods of IDRService so applications
+// can substitute a fake in their own tests.
+type IDRServicer interface {
+	Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error)
+	BatchCreate(ctx context.Context, reqs []*IDRRequest) ([]*IDRBatchResult, error)
+	GetStatus(ctx context.Context, transactionID string) (*IDRStatusResponse, error)
+	GetStatusByPayoutID(ctx context.Context, payoutID string) (*IDRStatusResponse, error)
+	GetStatusBatch(ctx context.Context, transactionIDs []string, concurrency int) (map[string]*IDRStatusResponse, map[string]error)
+	EstimateSettlementTime(bankCode string) (time.Duration, error)
+	WaitForCompletion(ctx context.Context, transactionID string, interval time.Duration) (*IDRStatusResponse, error)
+	VerifySignature(payoutID, accountNumber, amountStr, transactionID, signature string) error
+	VerifyStatusSignature(status *IDRStatusResponse) error
+	VerifySignatureFromStatusResponse(status *IDRStatusResponse) error
+	VerifyCallback(cb *IDRCallback) error
+	VerifyCallbackWithIP(cb *IDRCallback, remoteAddr string) error
+	ParseCallbackFromRequest(r *http.Request) (*IDRCallback, error)
+	ParseAndVerifyCallbackFromRequest(r *http.Request) (*IDRCallback, error)
+}
+
+// MYRServicer captures the exported methods of MYRService.
+type MYRServicer interface {
+	Create(ctx context.Context, req *MYRRequest) (*MYRResponse, error)
+	GetStatus(ctx context.Context, transactionID string) (*MYRStatusResponse, error)
+	VerifySignature(payoutID, accountNumber, amountStr, transactionID, signature string) error
+	VerifyCallback(cb *MYRCallback) error
+	VerifyCallbackWithIP(cb *MYRCallback, remoteAddr string) error
+}
+
+// Compile-time checks that the concrete services satisfy their interfaces.
+var (
+	_ IDRServicer = (*IDRService)(nil)
+	_ MYRServicer = (*MYRService)(nil)
+)
@@ -0,0 +1,36 @@
+package payout
+
+import (
+	"context"
+	"testing"
+)
+
+// mockIDRServicer is a handwritten mock of IDRServicer. Embedding the
+// interface keeps the mock small: only the methods a test overrides need
+// a func field, and calling anything else panics loudly.
+type mockIDRServicer struct {
+	IDRServicer
+	createFunc func(ctx context.Context, req *IDRRequest) (*IDRResponse, error)
+}
+
+func (m *mockIDRServicer) Create(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
+	return m.createFunc(ctx, req)
+}
+
+var _ IDRServicer = (*mockIDRServicer)(nil)
+
+func TestMockIDRServicerSubstitutes(t *testing.T) {
+	mock := &mockIDRServicer{
+		createFunc: func(ctx context.Context, req *IDRRequest) (*IDRResponse, error) {
+			return &IDRResponse{TransactionID: req.TransactionID}, nil
+		},
+	}
+	var svc IDRServicer = mock
+	resp, err := svc.Create(context.Background(), &IDRRequest{TransactionID: "TXN12345"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.TransactionID != "TXN12345" {
+		t.Errorf("TransactionID = %q, want TXN12345", resp.TransactionID)
+	}
+}
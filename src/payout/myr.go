@@ -0,0 +1,179 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/helper/amount"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// MYRService creates and tracks Malaysian Ringgit payouts to bank
+// accounts.
+type MYRService struct {
+	client client.Doer
+}
+
+// NewMYRService returns an MYRService backed by the given client.
+func NewMYRService(c client.Doer) *MYRService {
+	return &MYRService{client: c}
+}
+
+// MYRRequest describes a new MYR payout.
+type MYRRequest struct {
+	// AccountName is the beneficiary account holder name.
+	AccountName string
+	// AccountNumber is the beneficiary account number.
+	AccountNumber string
+	// BankCode selects the receiving bank and must be a key of
+	// constants.BanksMYR (case-insensitive).
+	BankCode string
+	// Amount is the payout amount in sen (MYR cents).
+	Amount int64
+	// TransactionID is the operator's unique reference, 5–20 characters.
+	TransactionID string
+	// Username identifies the receiving end user on the operator's side.
+	Username string
+}
+
+// MYRResponse is the payload returned when an MYR payout is created.
+type MYRResponse struct {
+	MYRPayoutID   json.Number             `json:"myrpayout_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        string                  `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+}
+
+// MYRStatusResponse is the payload returned by GetStatus.
+type MYRStatusResponse struct {
+	MYRPayoutID   json.Number             `json:"myrpayout_id"`
+	AccountNumber string                  `json:"account_number"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Completed     bool                    `json:"completed"`
+	PayoutSuccess bool                    `json:"payout_success"`
+	Remark        string                  `json:"remark"`
+	Signature     string                  `json:"signature"`
+}
+
+// MYRCallback is the JSON body GSPAY2 posts to the operator's callback URL
+// when an MYR payout reaches a final state.
+type MYRCallback struct {
+	MYRPayoutID   json.Number             `json:"myrpayout_id"`
+	AccountNumber string                  `json:"account_number"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Signature     string                  `json:"signature"`
+}
+
+type myrCreateRequest struct {
+	AccountName   string `json:"account_name"`
+	AccountNumber string `json:"account_number"`
+	BankCode      string `json:"bank_code"`
+	Amount        int64  `json:"amount"`
+	TransactionID string `json:"transaction_id"`
+	Username      string `json:"username"`
+}
+
+// Create submits a new MYR payout.
+func (s *MYRService) Create(ctx context.Context, req *MYRRequest) (*MYRResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payout.myr.create")
+	defer span.End()
+	if l := len(req.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		return nil, s.client.Error(errors.ErrInvalidTransactionID, req.TransactionID)
+	}
+	bankCode := strings.ToUpper(req.BankCode)
+	if !constants.IsValidBankMYR(bankCode) {
+		return nil, s.client.Error(errors.ErrInvalidBankCode, req.BankCode)
+	}
+	if req.Amount < constants.MinAmountMYR {
+		return nil, s.client.Error(errors.ErrInvalidAmount, req.Amount)
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingMYRPayout),
+		"transactionID", req.TransactionID,
+		"amount", req.Amount,
+		"bankCode", bankCode,
+		"accountNumber", s.client.LogAccountNumber(req.AccountNumber),
+		"accountName", s.client.LogAccountName(req.AccountName))
+	endpoint := s.client.Endpoint(constants.EndpointPayoutMYRCreate)
+	var resp MYRResponse
+	if err := s.client.Post(ctx, endpoint, &myrCreateRequest{
+		AccountName:   req.AccountName,
+		AccountNumber: req.AccountNumber,
+		BankCode:      bankCode,
+		Amount:        req.Amount,
+		TransactionID: req.TransactionID,
+		Username:      req.Username,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogMYRPayoutCreated),
+		"transactionID", resp.TransactionID,
+		"payoutID", resp.MYRPayoutID.String())
+	return &resp, nil
+}
+
+// GetStatus fetches the current status of an MYR payout by the operator's
+// transaction ID.
+func (s *MYRService) GetStatus(ctx context.Context, transactionID string) (*MYRStatusResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payout.myr.get_status")
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingMYRPayoutStatus),
+		"transactionID", transactionID)
+	endpoint := s.client.Endpoint(constants.EndpointPayoutMYRStatus)
+	endpoint += "?transaction_id=" + url.QueryEscape(transactionID)
+	var status MYRStatusResponse
+	if err := s.client.Get(ctx, endpoint, &status); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogMYRPayoutStatusRetrieved),
+		"transactionID", status.TransactionID,
+		"accountNumber", s.client.LogAccountNumber(status.AccountNumber),
+		"status", status.Status.String())
+	return &status, nil
+}
+
+// VerifySignature checks a payout signature built from the raw field
+// values: MD5(myrpayout_id + account_number + amount + transaction_id +
+// secret_key), where amount is normalized to two decimal places.
+func (s *MYRService) VerifySignature(payoutID, accountNumber, amountStr, transactionID, signature string) error {
+	if payoutID == "" || accountNumber == "" || amountStr == "" || transactionID == "" || signature == "" {
+		return s.client.Error(errors.ErrMissingCallbackField)
+	}
+	formatted, err := amount.Format(amountStr, s.client.Lang())
+	if err != nil {
+		return err
+	}
+	if !s.client.VerifySignature(signature, payoutID, accountNumber, formatted, transactionID) {
+		return s.client.Error(errors.ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// VerifyCallback checks the signature on a callback body. Callers that
+// know the source address should prefer VerifyCallbackWithIP.
+func (s *MYRService) VerifyCallback(cb *MYRCallback) error {
+	return s.VerifySignature(
+		cb.MYRPayoutID.String(),
+		cb.AccountNumber,
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Signature,
+	)
+}
+
+// VerifyCallbackWithIP checks the callback source address against the
+// configured whitelist and then verifies the callback signature.
+func (s *MYRService) VerifyCallbackWithIP(cb *MYRCallback, remoteAddr string) error {
+	if err := s.client.VerifyCallbackIP(remoteAddr); err != nil {
+		return err
+	}
+	return s.VerifyCallback(cb)
+}
@@ -0,0 +1,106 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestMYRServiceCreateValidation(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewMYRService(c)
+
+	_, err := svc.Create(context.Background(), &MYRRequest{
+		AccountName:   "Ahmad Bin Ali",
+		AccountNumber: "112233445566",
+		BankCode:      "MAYBANK",
+		Amount:        5000,
+		TransactionID: "abc",
+	})
+	if !errors.Is(err, errors.ErrInvalidTransactionID) {
+		t.Errorf("short transaction ID: error = %v, want ErrInvalidTransactionID", err)
+	}
+
+	_, err = svc.Create(context.Background(), &MYRRequest{
+		AccountName:   "Ahmad Bin Ali",
+		AccountNumber: "112233445566",
+		BankCode:      "NOTABANK",
+		Amount:        5000,
+		TransactionID: "TXN12345",
+	})
+	if !errors.Is(err, errors.ErrInvalidBankCode) {
+		t.Errorf("unknown bank: error = %v, want ErrInvalidBankCode", err)
+	}
+
+	_, err = svc.Create(context.Background(), &MYRRequest{
+		AccountName:   "Ahmad Bin Ali",
+		AccountNumber: "112233445566",
+		BankCode:      "MAYBANK",
+		Amount:        50,
+		TransactionID: "TXN12345",
+	})
+	if !errors.Is(err, errors.ErrInvalidAmount) {
+		t.Errorf("low amount: error = %v, want ErrInvalidAmount", err)
+	}
+}
+
+func TestMYRServiceCreateNormalizesBankCode(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			BankCode string `json:"bank_code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.BankCode != "RHB" {
+			t.Errorf("bank_code = %q, want RHB", body.BankCode)
+		}
+		w.Write(envelope(t, map[string]any{
+			"myrpayout_id":   88,
+			"transaction_id": "TXN12345",
+			"amount":         "50.00",
+			"status":         0,
+		}))
+	})
+	svc := NewMYRService(c)
+	resp, err := svc.Create(context.Background(), &MYRRequest{
+		AccountName:   "Ahmad Bin Ali",
+		AccountNumber: "112233445566",
+		BankCode:      "rhb",
+		Amount:        5000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.MYRPayoutID.String() != "88" {
+		t.Errorf("MYRPayoutID = %q, want 88", resp.MYRPayoutID)
+	}
+}
+
+func TestMYRServiceVerifyCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewMYRService(c)
+	cb := &MYRCallback{
+		MYRPayoutID:   "88",
+		AccountNumber: "112233445566",
+		TransactionID: "TXN12345",
+		Amount:        "50",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("88" + "112233445566" + "50.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Fatalf("VerifyCallback: %v", err)
+	}
+
+	cb.Signature = md5Hex("bad")
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+}
@@ -0,0 +1,28 @@
+package payout
+
+import (
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// EstimateSettlementTime returns the estimated settlement time for payouts
+// to the given bank or e-wallet code (case-insensitive). The figure comes
+// from constants.BankSettlementTimes and is an estimate for display only;
+// codes without an entry fall back to constants.DefaultSettlementTime.
+// Unknown bank codes are rejected.
+func (s *IDRService) EstimateSettlementTime(bankCode string) (time.Duration, error) {
+	if !constants.IsValidBankIDR(bankCode) {
+		return 0, errors.NewValidationError(s.client.Lang(), "bank_code", i18n.MsgInvalidBankCode, errors.ErrInvalidBankCode)
+	}
+	return constants.SettlementTime(bankCode), nil
+}
+
+// EstimatedSettlementTime returns the settlement estimate for the request's
+// bank code, or constants.DefaultSettlementTime when the code has no entry.
+// It is an estimate for display only.
+func (r *IDRRequest) EstimatedSettlementTime() time.Duration {
+	return constants.SettlementTime(r.BankCode)
+}
@@ -0,0 +1,54 @@
+package payout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestEstimateSettlementTime(t *testing.T) {
+	s := NewIDRService(client.New(testAuthKey, testSecretKey))
+	tests := []struct {
+		bankCode string
+		want     time.Duration
+	}{
+		{"BCA", 2 * time.Hour},
+		{"dana", 30 * time.Minute},
+		{"SINARMAS", 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		got, err := s.EstimateSettlementTime(tt.bankCode)
+		if err != nil {
+			t.Errorf("EstimateSettlementTime(%q): %v", tt.bankCode, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("EstimateSettlementTime(%q) = %v, want %v", tt.bankCode, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateSettlementTimeUnknownBank(t *testing.T) {
+	s := NewIDRService(client.New(testAuthKey, testSecretKey))
+	if _, err := s.EstimateSettlementTime("NOTABANK"); !errors.Is(err, errors.ErrInvalidBankCode) {
+		t.Errorf("EstimateSettlementTime error = %v, want ErrInvalidBankCode", err)
+	}
+}
+
+func TestRequestEstimatedSettlementTime(t *testing.T) {
+	req := &IDRRequest{BankCode: "BCA"}
+	if got := req.EstimatedSettlementTime(); got != 2*time.Hour {
+		t.Errorf("EstimatedSettlementTime = %v, want 2h", got)
+	}
+	req.BankCode = "BTN"
+	if got := req.EstimatedSettlementTime(); got != 6*time.Hour {
+		t.Errorf("EstimatedSettlementTime = %v, want 6h", got)
+	}
+	req.BankCode = "SOMETHING"
+	if got := req.EstimatedSettlementTime(); got != constants.DefaultSettlementTime {
+		t.Errorf("EstimatedSettlementTime = %v, want the default", got)
+	}
+}
@@ -0,0 +1,26 @@
+package payout
+
+// Semantic helpers over the multi-field status of an IDR payout, so
+// callers do not need to combine Completed, PayoutSuccess and Status
+// themselves.
+
+// IsCompleted reports whether the payout has reached a final state.
+func (r *IDRStatusResponse) IsCompleted() bool { return r.Completed }
+
+// IsSuccessful reports whether the payout completed and the funds were
+// disbursed.
+func (r *IDRStatusResponse) IsSuccessful() bool {
+	return r.Completed && r.PayoutSuccess && r.Status.IsSuccess()
+}
+
+// IsFailed reports whether the payout completed without the funds being
+// disbursed.
+func (r *IDRStatusResponse) IsFailed() bool {
+	return r.Completed && (!r.PayoutSuccess || r.Status.IsFailed())
+}
+
+// IsProcessing reports whether the payout is still in flight: either
+// accepted and explicitly processing (status 3), or still pending.
+func (r *IDRStatusResponse) IsProcessing() bool {
+	return !r.Completed && (r.Status.IsProcessing() || r.Status.IsPending())
+}
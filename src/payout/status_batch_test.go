@@ -0,0 +1,70 @@
+package payout
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func payoutStatusBatchHandler(t *testing.T, hits *sync.Map) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("transaction_id")
+		if hits != nil {
+			count, _ := hits.LoadOrStore(id, new(atomic.Int64))
+			count.(*atomic.Int64).Add(1)
+		}
+		w.Write(envelope(t, map[string]any{
+			"idrpayout_id":   "42",
+			"account_number": "1234567890",
+			"transaction_id": id,
+			"amount":         "50000.00",
+			"status":         1,
+			"completed":      true,
+			"payout_success": true,
+		}))
+	}
+}
+
+func TestPayoutGetStatusBatchSuccess(t *testing.T) {
+	svc := NewIDRService(newTestClient(t, payoutStatusBatchHandler(t, nil)))
+	ids := []string{"PO1", "PO2", "PO3"}
+	statuses, failures := svc.GetStatusBatch(context.Background(), ids, 2)
+	if len(failures) != 0 {
+		t.Fatalf("failures = %v, want none", failures)
+	}
+	for _, id := range ids {
+		if status, ok := statuses[id]; !ok || status.TransactionID != id {
+			t.Errorf("statuses[%s] = %+v, want a status for that ID", id, status)
+		}
+	}
+}
+
+func TestPayoutGetStatusBatchPartialFailureAndDedup(t *testing.T) {
+	var hits sync.Map
+	svc := NewIDRService(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("transaction_id") == "PO-BAD" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"not found"}`))
+			return
+		}
+		payoutStatusBatchHandler(t, &hits)(w, r)
+	}))
+	statuses, failures := svc.GetStatusBatch(context.Background(),
+		[]string{"PO1", "PO-BAD", "PO1", "PO2"}, 3)
+	if len(statuses) != 2 {
+		t.Errorf("statuses = %d entries, want 2", len(statuses))
+	}
+	if err := failures["PO-BAD"]; errors.GetAPIError(err) == nil {
+		t.Errorf("failures[PO-BAD] = %v, want an APIError", err)
+	}
+	hits.Range(func(id, count any) bool {
+		if n := count.(*atomic.Int64).Load(); n != 1 {
+			t.Errorf("%s fetched %d times, want once", id, n)
+		}
+		return true
+	})
+}
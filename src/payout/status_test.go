@@ -0,0 +1,43 @@
+package payout
+
+import (
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+func TestIDRPayoutStatusSemanticHelpers(t *testing.T) {
+	tests := []struct {
+		name          string
+		completed     bool
+		payoutSuccess bool
+		status        constants.PaymentStatus
+		successful    bool
+		failed        bool
+		processing    bool
+	}{
+		{"pending", false, false, constants.StatusPending, false, false, true},
+		{"completed success", true, true, constants.StatusSuccess, true, false, false},
+		{"completed success wrong status", true, true, constants.StatusFailed, false, true, false},
+		{"completed no success flag", true, false, constants.StatusSuccess, false, true, false},
+		{"completed failed", true, false, constants.StatusFailed, false, true, false},
+		{"incomplete failed", false, false, constants.StatusFailed, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &IDRStatusResponse{Completed: tt.completed, PayoutSuccess: tt.payoutSuccess, Status: tt.status}
+			if got := r.IsCompleted(); got != tt.completed {
+				t.Errorf("IsCompleted = %v, want %v", got, tt.completed)
+			}
+			if got := r.IsSuccessful(); got != tt.successful {
+				t.Errorf("IsSuccessful = %v, want %v", got, tt.successful)
+			}
+			if got := r.IsFailed(); got != tt.failed {
+				t.Errorf("IsFailed = %v, want %v", got, tt.failed)
+			}
+			if got := r.IsProcessing(); got != tt.processing {
+				t.Errorf("IsProcessing = %v, want %v", got, tt.processing)
+			}
+		})
+	}
+}
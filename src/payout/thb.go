@@ -0,0 +1,178 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+	"github.com/nikon1313/gspay-go-sdk/src/helper/amount"
+	"github.com/nikon1313/gspay-go-sdk/src/i18n"
+)
+
+// THBService creates and tracks Thai Baht payouts to bank accounts.
+type THBService struct {
+	client client.Doer
+}
+
+// NewTHBService returns a THBService backed by the given client.
+func NewTHBService(c client.Doer) *THBService {
+	return &THBService{client: c}
+}
+
+// THBRequest describes a new THB payout.
+type THBRequest struct {
+	// AccountName is the beneficiary account holder name.
+	AccountName string
+	// AccountNumber is the beneficiary account number.
+	AccountNumber string
+	// BankCode selects the receiving bank and must be a key of
+	// constants.BanksTHB (case-insensitive).
+	BankCode string
+	// Amount is the payout amount in satang (THB cents).
+	Amount int64
+	// TransactionID is the operator's unique reference, 5–20 characters.
+	TransactionID string
+	// Username identifies the receiving end user on the operator's side.
+	Username string
+}
+
+// THBResponse is the payload returned when a THB payout is created.
+type THBResponse struct {
+	THBPayoutID   json.Number             `json:"thbpayout_id"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        string                  `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+}
+
+// THBStatusResponse is the payload returned by GetStatus.
+type THBStatusResponse struct {
+	THBPayoutID   json.Number             `json:"thbpayout_id"`
+	AccountNumber string                  `json:"account_number"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Completed     bool                    `json:"completed"`
+	PayoutSuccess bool                    `json:"payout_success"`
+	Remark        string                  `json:"remark"`
+	Signature     string                  `json:"signature"`
+}
+
+// THBCallback is the JSON body GSPAY2 posts to the operator's callback URL
+// when a THB payout reaches a final state.
+type THBCallback struct {
+	THBPayoutID   json.Number             `json:"thbpayout_id"`
+	AccountNumber string                  `json:"account_number"`
+	TransactionID string                  `json:"transaction_id"`
+	Amount        json.Number             `json:"amount"`
+	Status        constants.PaymentStatus `json:"status"`
+	Signature     string                  `json:"signature"`
+}
+
+type thbCreateRequest struct {
+	AccountName   string `json:"account_name"`
+	AccountNumber string `json:"account_number"`
+	BankCode      string `json:"bank_code"`
+	Amount        int64  `json:"amount"`
+	TransactionID string `json:"transaction_id"`
+	Username      string `json:"username"`
+}
+
+// Create submits a new THB payout.
+func (s *THBService) Create(ctx context.Context, req *THBRequest) (*THBResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payout.thb.create")
+	defer span.End()
+	if l := len(req.TransactionID); l < constants.MinTransactionIDLength || l > constants.MaxTransactionIDLength {
+		return nil, s.client.Error(errors.ErrInvalidTransactionID, req.TransactionID)
+	}
+	bankCode := strings.ToUpper(req.BankCode)
+	if !constants.IsValidBankTHB(bankCode) {
+		return nil, s.client.Error(errors.ErrInvalidBankCode, req.BankCode)
+	}
+	if req.Amount < constants.MinAmountTHB {
+		return nil, s.client.Error(errors.ErrInvalidAmount, req.Amount)
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogCreatingTHBPayout),
+		"transactionID", req.TransactionID,
+		"amount", req.Amount,
+		"bankCode", bankCode,
+		"accountNumber", s.client.LogAccountNumber(req.AccountNumber),
+		"accountName", s.client.LogAccountName(req.AccountName))
+	endpoint := s.client.Endpoint(constants.EndpointPayoutTHBCreate)
+	var resp THBResponse
+	if err := s.client.Post(ctx, endpoint, &thbCreateRequest{
+		AccountName:   req.AccountName,
+		AccountNumber: req.AccountNumber,
+		BankCode:      bankCode,
+		Amount:        req.Amount,
+		TransactionID: req.TransactionID,
+		Username:      req.Username,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogTHBPayoutCreated),
+		"transactionID", resp.TransactionID,
+		"payoutID", resp.THBPayoutID.String())
+	return &resp, nil
+}
+
+// GetStatus fetches the current status of a THB payout by the operator's
+// transaction ID.
+func (s *THBService) GetStatus(ctx context.Context, transactionID string) (*THBStatusResponse, error) {
+	ctx, span := s.client.StartSpan(ctx, "gspay.payout.thb.get_status")
+	defer span.End()
+	s.client.Logger().Debug(s.client.I18n(i18n.LogQueryingTHBPayoutStatus),
+		"transactionID", transactionID)
+	endpoint := s.client.Endpoint(constants.EndpointPayoutTHBStatus)
+	endpoint += "?transaction_id=" + url.QueryEscape(transactionID)
+	var status THBStatusResponse
+	if err := s.client.Get(ctx, endpoint, &status); err != nil {
+		return nil, err
+	}
+	s.client.Logger().Info(s.client.I18n(i18n.LogTHBPayoutStatusRetrieved),
+		"transactionID", status.TransactionID,
+		"accountNumber", s.client.LogAccountNumber(status.AccountNumber),
+		"status", status.Status.String())
+	return &status, nil
+}
+
+// VerifySignature checks a payout signature built from the raw field
+// values: MD5(thbpayout_id + account_number + amount + transaction_id +
+// secret_key), where amount is normalized to two decimal places.
+func (s *THBService) VerifySignature(payoutID, accountNumber, amountStr, transactionID, signature string) error {
+	if payoutID == "" || accountNumber == "" || amountStr == "" || transactionID == "" || signature == "" {
+		return s.client.Error(errors.ErrMissingCallbackField)
+	}
+	formatted, err := amount.Format(amountStr, s.client.Lang())
+	if err != nil {
+		return err
+	}
+	if !s.client.VerifySignature(signature, payoutID, accountNumber, formatted, transactionID) {
+		return s.client.Error(errors.ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// VerifyCallback checks the signature on a callback body. Callers that
+// know the source address should prefer VerifyCallbackWithIP.
+func (s *THBService) VerifyCallback(cb *THBCallback) error {
+	return s.VerifySignature(
+		cb.THBPayoutID.String(),
+		cb.AccountNumber,
+		cb.Amount.String(),
+		cb.TransactionID,
+		cb.Signature,
+	)
+}
+
+// VerifyCallbackWithIP checks the callback source address against the
+// configured whitelist and then verifies the callback signature.
+func (s *THBService) VerifyCallbackWithIP(cb *THBCallback, remoteAddr string) error {
+	if err := s.client.VerifyCallbackIP(remoteAddr); err != nil {
+		return err
+	}
+	return s.VerifyCallback(cb)
+}
@@ -0,0 +1,131 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/client"
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+	"github.com/nikon1313/gspay-go-sdk/src/errors"
+)
+
+func TestTHBServiceCreateValidation(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewTHBService(c)
+
+	_, err := svc.Create(context.Background(), &THBRequest{
+		AccountName:   "Somchai Jaidee",
+		AccountNumber: "1234567890",
+		BankCode:      "KBANK",
+		Amount:        50000,
+		TransactionID: "abc",
+	})
+	if !errors.Is(err, errors.ErrInvalidTransactionID) {
+		t.Errorf("short transaction ID: error = %v, want ErrInvalidTransactionID", err)
+	}
+
+	_, err = svc.Create(context.Background(), &THBRequest{
+		AccountName:   "Somchai Jaidee",
+		AccountNumber: "1234567890",
+		BankCode:      "NOTABANK",
+		Amount:        50000,
+		TransactionID: "TXN12345",
+	})
+	if !errors.Is(err, errors.ErrInvalidBankCode) {
+		t.Errorf("unknown bank: error = %v, want ErrInvalidBankCode", err)
+	}
+
+	_, err = svc.Create(context.Background(), &THBRequest{
+		AccountName:   "Somchai Jaidee",
+		AccountNumber: "1234567890",
+		BankCode:      "KBANK",
+		Amount:        constants.MinAmountTHB - 1,
+		TransactionID: "TXN12345",
+	})
+	if !errors.Is(err, errors.ErrInvalidAmount) {
+		t.Errorf("low amount: error = %v, want ErrInvalidAmount", err)
+	}
+}
+
+func TestTHBServiceCreateNormalizesBankCode(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			BankCode string `json:"bank_code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.BankCode != "SCB" {
+			t.Errorf("bank_code = %q, want SCB", body.BankCode)
+		}
+		w.Write(envelope(t, map[string]any{
+			"thbpayout_id":   77,
+			"transaction_id": "TXN12345",
+			"amount":         "500.00",
+			"status":         0,
+		}))
+	})
+	svc := NewTHBService(c)
+	resp, err := svc.Create(context.Background(), &THBRequest{
+		AccountName:   "Somchai Jaidee",
+		AccountNumber: "1234567890",
+		BankCode:      "scb",
+		Amount:        50000,
+		TransactionID: "TXN12345",
+		Username:      "user1",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.THBPayoutID.String() != "77" {
+		t.Errorf("THBPayoutID = %q, want 77", resp.THBPayoutID)
+	}
+}
+
+func TestTHBServiceGetStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("transaction_id"); got != "TXN12345" {
+			t.Errorf("transaction_id = %q, want TXN12345", got)
+		}
+		w.Write(envelope(t, map[string]any{
+			"thbpayout_id":   77,
+			"account_number": "1234567890",
+			"transaction_id": "TXN12345",
+			"amount":         "500.00",
+			"status":         1,
+			"completed":      true,
+			"payout_success": true,
+		}))
+	})
+	svc := NewTHBService(c)
+	status, err := svc.GetStatus(context.Background(), "TXN12345")
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if status.Status != constants.StatusSuccess || !status.PayoutSuccess {
+		t.Errorf("status = %+v, want successful payout", status)
+	}
+}
+
+func TestTHBServiceVerifyCallback(t *testing.T) {
+	c := client.New(testAuthKey, testSecretKey)
+	svc := NewTHBService(c)
+	cb := &THBCallback{
+		THBPayoutID:   "77",
+		AccountNumber: "1234567890",
+		TransactionID: "TXN12345",
+		Amount:        "500",
+		Status:        constants.StatusSuccess,
+		Signature:     md5Hex("77" + "1234567890" + "500.00" + "TXN12345" + testSecretKey),
+	}
+	if err := svc.VerifyCallback(cb); err != nil {
+		t.Fatalf("VerifyCallback: %v", err)
+	}
+
+	cb.Signature = md5Hex("bad")
+	if err := svc.VerifyCallback(cb); !errors.Is(err, errors.ErrSignatureMismatch) {
+		t.Fatalf("error = %v, want ErrSignatureMismatch", err)
+	}
+}
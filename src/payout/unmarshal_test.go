@@ -0,0 +1,50 @@
+package payout
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/nikon1313/gspay-go-sdk/src/constants"
+)
+
+func TestIDRCallbackUnmarshalVariants(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+	}{
+		{"canonical types", `{"idrpayout_id":9,"account_number":"1234567890","transaction_id":"TXN12345","amount":"50000.00","status":1,"signature":"abc"}`},
+		{"string id and status", `{"idrpayout_id":"9","account_number":"1234567890","transaction_id":"TXN12345","amount":"50000.00","status":"1","signature":"abc"}`},
+		{"numeric amount", `{"idrpayout_id":9,"account_number":"1234567890","transaction_id":"TXN12345","amount":50000,"status":1,"signature":"abc"}`},
+	}
+	for _, tc := range cases {
+		var cb IDRCallback
+		if err := json.Unmarshal([]byte(tc.body), &cb); err != nil {
+			t.Fatalf("%s: Unmarshal: %v", tc.name, err)
+		}
+		if cb.IDRPayoutID.String() != "9" || cb.AccountNumber != "1234567890" || cb.Status != constants.StatusSuccess {
+			t.Errorf("%s: callback = %+v, want id 9, the account number and status success", tc.name, cb)
+		}
+	}
+}
+
+func TestIDRCallbackUnmarshalRejectsBadTypes(t *testing.T) {
+	cases := []struct {
+		field string
+		body  string
+	}{
+		{"idrpayout_id", `{"idrpayout_id":[9],"account_number":"1234567890","transaction_id":"TXN12345","amount":"50000.00","status":1}`},
+		{"account_number", `{"idrpayout_id":9,"account_number":1234567890,"transaction_id":"TXN12345","amount":"50000.00","status":1}`},
+		{"status", `{"idrpayout_id":9,"account_number":"1234567890","transaction_id":"TXN12345","amount":"50000.00","status":true}`},
+	}
+	for _, tc := range cases {
+		var cb IDRCallback
+		err := json.Unmarshal([]byte(tc.body), &cb)
+		if err == nil {
+			t.Fatalf("field %s: Unmarshal accepted a bad type", tc.field)
+		}
+		if !strings.Contains(err.Error(), tc.field) {
+			t.Errorf("field %s: error = %v, want the field named", tc.field, err)
+		}
+	}
+}
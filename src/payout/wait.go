@@ -0,0 +1,71 @@
+package payout
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MinPollInterval is the smallest interval WaitForCompletion accepts;
+// shorter intervals are raised to this floor to avoid hammering the API.
+const MinPollInterval = 2 * time.Second
+
+// pollFloor is the enforced interval floor; a variable so tests can poll
+// quickly against local mock servers.
+var pollFloor = MinPollInterval
+
+// WaitTimeoutError is returned by WaitForCompletion when the context
+// expires before the payout reaches a terminal status.
+type WaitTimeoutError struct {
+	lastStatus *IDRStatusResponse
+	err        error
+}
+
+// Error implements the error interface.
+func (e *WaitTimeoutError) Error() string {
+	if e.lastStatus != nil {
+		return fmt.Sprintf("gspay: wait for completion: %v (last status: %s)", e.err, e.lastStatus.Status)
+	}
+	return fmt.Sprintf("gspay: wait for completion: %v (no status retrieved)", e.err)
+}
+
+// Unwrap returns the underlying context error so errors.Is matches
+// context.DeadlineExceeded and context.Canceled.
+func (e *WaitTimeoutError) Unwrap() error { return e.err }
+
+// LastStatus returns the most recent status retrieved before the wait
+// was abandoned, or nil if no poll succeeded.
+func (e *WaitTimeoutError) LastStatus() *IDRStatusResponse { return e.lastStatus }
+
+// WaitForCompletion polls GetStatus every interval until the payout
+// reaches a terminal status (success, failed or timeout) and returns that
+// status. When the context expires first, the returned error is a
+// *WaitTimeoutError wrapping the context error and carrying the last
+// observed status. Intervals below MinPollInterval are raised to it.
+func (s *IDRService) WaitForCompletion(ctx context.Context, transactionID string, interval time.Duration) (*IDRStatusResponse, error) {
+	if interval < pollFloor {
+		interval = pollFloor
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var last *IDRStatusResponse
+	for {
+		status, err := s.GetStatus(ctx, transactionID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, &WaitTimeoutError{lastStatus: last, err: ctx.Err()}
+			}
+			return nil, err
+		}
+		last = status
+		switch {
+		case status.Status.IsSuccess(), status.Status.IsFailed(), status.Status.IsTimeout():
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, &WaitTimeoutError{lastStatus: last, err: ctx.Err()}
+		case <-ticker.C:
+		}
+	}
+}
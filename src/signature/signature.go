@@ -0,0 +1,32 @@
+// Package signature exposes the GSPAY2 signing scheme with a pluggable
+// hash function. The default scheme used by the services is MD5; GSPAY2
+// configurations that support stronger digests can supply their own
+// Digest via client.WithDigest.
+package signature
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"hash"
+)
+
+// Digest is a hash constructor, e.g. md5.New or sha256.New. HMAC schemes
+// can be plugged in with a closure over hmac.New.
+type Digest func() hash.Hash
+
+// MD5 is the default digest used by GSPAY2.
+func MD5() hash.Hash { return md5.New() }
+
+// Generate returns the lowercase hex MD5 digest of data, matching the
+// production GSPAY2 signing scheme.
+func Generate(data string) string {
+	return GenerateWithDigest(data, MD5)
+}
+
+// GenerateWithDigest returns the lowercase hex digest of data computed
+// with the supplied hash function.
+func GenerateWithDigest(data string, d Digest) string {
+	h := d()
+	h.Write([]byte(data))
+	return hex.EncodeToString(h.Sum(nil))
+}
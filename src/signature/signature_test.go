@@ -0,0 +1,27 @@
+package signature
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestGenerateMatchesMD5(t *testing.T) {
+	sum := md5.Sum([]byte("payload"))
+	want := hex.EncodeToString(sum[:])
+	if got := Generate("payload"); got != want {
+		t.Errorf("Generate = %q, want %q", got, want)
+	}
+	if got := GenerateWithDigest("payload", MD5); got != want {
+		t.Errorf("GenerateWithDigest(MD5) = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateWithDigestSHA256(t *testing.T) {
+	sum := sha256.Sum256([]byte("payload"))
+	want := hex.EncodeToString(sum[:])
+	if got := GenerateWithDigest("payload", sha256.New); got != want {
+		t.Errorf("GenerateWithDigest(sha256) = %q, want %q", got, want)
+	}
+}